@@ -0,0 +1,48 @@
+package command
+
+import (
+	"bytes"
+	"math"
+	"redis-challenge/internal/protocol"
+	"strconv"
+)
+
+// parseBulkStrings returns the text of every argument, or an error for the first argument that is not a bulk string.
+func parseBulkStrings(arguments []protocol.Data) ([]string, protocol.Data) {
+	values := make([]string, len(arguments))
+	for i, arg := range arguments {
+		if text, ok := arg.(protocol.BulkString); ok {
+			values[i] = string(text)
+			continue
+		}
+
+		return nil, NewWrongDataTypeError(arg, protocol.BulkStringSymbol)
+	}
+	return values, nil
+}
+
+// encodeRequest returns the arguments as a RESP array of bulk strings, for commands which record a different
+// request in the command log than the one they were called with.
+func encodeRequest(arguments ...string) []byte {
+	data := make([]protocol.Data, len(arguments))
+	for i, argument := range arguments {
+		data[i] = protocol.NewBulkString(argument)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	_ = protocol.WriteData(buffer, protocol.NewArray(data))
+	return buffer.Bytes()
+}
+
+// parseRandomCount parses the count of members to pick at random, which like Redis must be within -LONG_MAX and
+// LONG_MAX.
+func parseRandomCount(value string) (int, protocol.Data) {
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	if count < -math.MaxInt64 {
+		return 0, protocol.NewSimpleError("ERR value is out of range")
+	}
+	return int(count), nil
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+// ErrorBlocked is returned by executing a blocking command when none of its keys hold a value, so the command
+// waits for another command to push to one of its keys.
+var ErrorBlocked = errors.New("blocked waiting for keys")
+
+// BlockingCommand is a command that waits for a list to be pushed to one of its keys when they are all empty.
+type BlockingCommand interface {
+	Command
+	// Keys returns the keys the command waits on, in the order they are checked.
+	Keys() []string
+	// ExecuteOnKey completes the command using the list at the key, which is known to hold values.
+	ExecuteOnKey(s store.Store, key string) (protocol.Data, error)
+	// Deadline returns the time in milliseconds when the command stops waiting, or zero to wait forever.
+	Deadline() int64
+	// TimeoutResponse returns the response when the deadline passes without a key holding values.
+	TimeoutResponse() protocol.Data
+}
+
+// parseTimeout returns the deadline for a timeout in seconds, which may be fractional, or zero to wait forever.
+func parseTimeout(clock store.Clock, value string) (int64, protocol.Data) {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(seconds) {
+		return 0, protocol.NewSimpleError("ERR timeout is not a float or out of range")
+	}
+
+	milliseconds := math.Ceil(seconds * 1000)
+	if milliseconds < 0 {
+		return 0, protocol.NewSimpleError("ERR timeout is negative")
+	}
+	if milliseconds == 0 {
+		return 0, nil
+	}
+
+	now := clock.Now()
+	if milliseconds >= float64(math.MaxInt64-now) {
+		return 0, protocol.NewSimpleError("ERR timeout is out of range")
+	}
+	return now + int64(milliseconds), nil
+}
+
+// blockedExecution is an execution of a blocking command waiting for one of its keys to hold values.
+type blockedExecution struct {
+	execution
+	cmd BlockingCommand
+}
+
+// blockedExecutions holds the blocked commands in the order they arrived, so the command waiting longest on a key
+// is served first.
+type blockedExecutions struct {
+	waiting []blockedExecution
+}
+
+func (b *blockedExecutions) add(e execution, cmd BlockingCommand) {
+	b.waiting = append(b.waiting, blockedExecution{execution: e, cmd: cmd})
+}
+
+// remove stops the command waiting, such as when its client disconnects.
+func (b *blockedExecutions) remove(cmd Command) {
+	for i, w := range b.waiting {
+		if w.cmd == cmd {
+			b.waiting = append(b.waiting[:i:i], b.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// expire removes the commands whose deadline has passed, returning them in the order they arrived.
+func (b *blockedExecutions) expire(now int64) []blockedExecution {
+	var expired []blockedExecution
+	remaining := b.waiting[:0]
+	for _, w := range b.waiting {
+		if deadline := w.cmd.Deadline(); deadline != 0 && deadline <= now {
+			expired = append(expired, w)
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	clear(b.waiting[len(remaining):])
+	b.waiting = remaining
+	return expired
+}
+
+// next removes and returns the longest waiting command with a key holding a list in its database, with the key to
+// serve it from.
+func (b *blockedExecutions) next(databases *store.Databases) (blockedExecution, string, bool) {
+	for i, w := range b.waiting {
+		s := databases.Store(w.database)
+		for _, key := range w.cmd.Keys() {
+			if length, err := s.ListLength(key); err == nil && length > 0 {
+				b.waiting = append(b.waiting[:i:i], b.waiting[i+1:]...)
+				return w, key, true
+			}
+		}
+	}
+	return blockedExecution{}, "", false
+}
@@ -18,10 +18,12 @@ func (cmd ChangeIntegerCommand) Request() ([]byte, Type) {
 
 func (cmd ChangeIntegerCommand) Execute(s store.Store) (protocol.Data, error) {
 	value, err := s.Increment(cmd.key, cmd.change)
-	if errors.Is(err, store.ErrorWrongOperationType) {
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
 		return NewWrongOperationTypeError(), nil
-	}
-	if err != nil {
+	case errors.Is(err, store.ErrorOverflow):
+		return protocol.NewSimpleError("ERR increment or decrement would overflow"), nil
+	case err != nil:
 		return protocol.NewSimpleError("ERR value is not an integer or out of range"), nil
 	}
 
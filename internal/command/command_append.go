@@ -0,0 +1,63 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+// maximumStringLength is the largest string that can be built by APPEND or SETRANGE, which is 512MB.
+const maximumStringLength = 512 * 1024 * 1024
+
+func newStringTooLongError() protocol.SimpleError {
+	return protocol.NewSimpleError("ERR string exceeds maximum allowed size (proto-max-bulk-len)")
+}
+
+type AppendValidator struct{}
+
+func (AppendValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("append")
+	}
+
+	return AppendCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		value:        values[1],
+	}, nil
+}
+
+// AppendCommand adds the value to the end of the string at the key, keeping any expiry of the key.
+type AppendCommand struct {
+	requestBytes []byte
+	key          string
+	value        string
+}
+
+func (cmd AppendCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd AppendCommand) Execute(s store.Store) (protocol.Data, error) {
+	current, err := s.ReadString(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil && !errors.Is(err, store.ErrorKeyNotFound) {
+		return nil, err
+	}
+
+	if len(current)+len(cmd.value) > maximumStringLength {
+		return newStringTooLongError(), nil
+	}
+
+	updated := current + cmd.value
+	s.UpdateString(cmd.key, updated, "append")
+	return protocol.NewSimpleInteger(int64(len(updated))), nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type BLMoveValidator struct {
+	clock store.Clock
+}
+
+func (v BLMoveValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 5 {
+		return nil, NewWrongNumberOfArgumentsError("blmove")
+	}
+
+	move, errorData := parseListMove(values[:4])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	deadline, errorData := parseTimeout(v.clock, values[4])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return &BLMoveCommand{listMove: move, deadline: deadline}, nil
+}
+
+// BLMoveCommand pops a value from one end of the source list and pushes it to an end of the destination list,
+// waiting for a value to be pushed to the source when it is empty. It is recorded in the command log as an LMOVE.
+type BLMoveCommand struct {
+	listMove
+	deadline int64
+	moved    bool
+}
+
+func (cmd *BLMoveCommand) Request() ([]byte, Type) {
+	if !cmd.moved {
+		return nil, TypeUpdate
+	}
+	return cmd.request(), TypeUpdate
+}
+
+func (cmd *BLMoveCommand) Execute(s store.Store) (protocol.Data, error) {
+	return cmd.ExecuteOnKey(s, cmd.source)
+}
+
+func (cmd *BLMoveCommand) ExecuteOnKey(s store.Store, _ string) (protocol.Data, error) {
+	data, moved, err := cmd.move(s)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrorBlocked
+	}
+
+	cmd.moved = moved
+	return data, nil
+}
+
+func (cmd *BLMoveCommand) Keys() []string {
+	return []string{cmd.source}
+}
+
+func (cmd *BLMoveCommand) Deadline() int64 {
+	return cmd.deadline
+}
+
+func (cmd *BLMoveCommand) TimeoutResponse() protocol.Data {
+	return nil
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type BLMPopValidator struct {
+	clock store.Clock
+}
+
+func (v BLMPopValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 4 {
+		return nil, NewWrongNumberOfArgumentsError("blmpop")
+	}
+
+	deadline, errorData := parseTimeout(v.clock, values[0])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	multiPop, errorData := parseListMultiPop(values[1:])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return &BLMPopCommand{listMultiPop: multiPop, deadline: deadline}, nil
+}
+
+// BLMPopCommand pops from the first key holding a list, waiting for a value to be pushed when every list is
+// empty. It is recorded in the command log as an LPOP or RPOP of the key which was popped.
+type BLMPopCommand struct {
+	listMultiPop
+	deadline  int64
+	poppedKey string
+}
+
+func (cmd *BLMPopCommand) Request() ([]byte, Type) {
+	if cmd.poppedKey == "" {
+		return nil, TypeUpdate
+	}
+	return cmd.request(cmd.poppedKey), TypeUpdate
+}
+
+func (cmd *BLMPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	data, key, err := cmd.popFirst(s)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrorBlocked
+	}
+
+	if key != "" {
+		cmd.poppedKey = key
+	}
+	return data, nil
+}
+
+func (cmd *BLMPopCommand) ExecuteOnKey(s store.Store, key string) (protocol.Data, error) {
+	data, err := cmd.pop(s, key)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrorBlocked
+	}
+
+	cmd.poppedKey = key
+	return data, nil
+}
+
+func (cmd *BLMPopCommand) Keys() []string {
+	return cmd.keys
+}
+
+func (cmd *BLMPopCommand) Deadline() int64 {
+	return cmd.deadline
+}
+
+func (cmd *BLMPopCommand) TimeoutResponse() protocol.Data {
+	return protocol.NewNullArray()
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type BLPopValidator struct {
+	clock     store.Clock
+	fromRight bool
+}
+
+func (v BLPopValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		if v.fromRight {
+			return nil, NewWrongNumberOfArgumentsError("brpop")
+		}
+		return nil, NewWrongNumberOfArgumentsError("blpop")
+	}
+
+	deadline, errorData := parseTimeout(v.clock, values[len(values)-1])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return &BLPopCommand{
+		keys:      values[:len(values)-1],
+		deadline:  deadline,
+		fromRight: v.fromRight,
+	}, nil
+}
+
+// BLPopCommand pops a value from the start of the first key holding a list, or from the end for BRPOP, waiting
+// for a value to be pushed when every list is empty. It is recorded in the command log as an LPOP or RPOP of the
+// key which was popped.
+type BLPopCommand struct {
+	keys      []string
+	deadline  int64
+	fromRight bool
+	poppedKey string
+}
+
+func (cmd *BLPopCommand) Request() ([]byte, Type) {
+	if cmd.poppedKey == "" {
+		return nil, TypeUpdate
+	}
+
+	if cmd.fromRight {
+		return encodeRequest("RPOP", cmd.poppedKey), TypeUpdate
+	}
+	return encodeRequest("LPOP", cmd.poppedKey), TypeUpdate
+}
+
+func (cmd *BLPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	for _, key := range cmd.keys {
+		length, err := s.ListLength(key)
+
+		if errors.Is(err, store.ErrorWrongOperationType) {
+			return NewWrongOperationTypeError(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if length > 0 {
+			return cmd.ExecuteOnKey(s, key)
+		}
+	}
+	return nil, ErrorBlocked
+}
+
+func (cmd *BLPopCommand) ExecuteOnKey(s store.Store, key string) (protocol.Data, error) {
+	pop := s.LeftPop
+	if cmd.fromRight {
+		pop = s.RightPop
+	}
+
+	popped, err := pop(key, 1)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(popped) == 0 {
+		return nil, ErrorBlocked
+	}
+
+	cmd.poppedKey = key
+	return newBulkStringsData([]string{key, popped[0]}), nil
+}
+
+func (cmd *BLPopCommand) Keys() []string {
+	return cmd.keys
+}
+
+func (cmd *BLPopCommand) Deadline() int64 {
+	return cmd.deadline
+}
+
+func (cmd *BLPopCommand) TimeoutResponse() protocol.Data {
+	return protocol.NewNullArray()
+}
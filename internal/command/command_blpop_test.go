@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingBlockingCommands(t *testing.T) {
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(&store.FixedClock{}).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	requests := func(t *testing.T, requestBytes []byte) []protocol.Data {
+		var result []protocol.Data
+		for len(requestBytes) > 0 {
+			request, byteCount := protocol.ReadFrame(requestBytes)
+			require.NotZero(t, byteCount)
+			result = append(result, request)
+			requestBytes = requestBytes[byteCount:]
+		}
+		return result
+	}
+
+	t.Run("brpop command normalises to RPOP of the key which was popped", func(t *testing.T) {
+		// Given a list in the second key
+		s := store.New()
+		_, err := s.RightPush("second", []string{"a", "b"})
+		require.NoError(t, err)
+
+		// When we pop from either key
+		cmd := validate(t, "BRPOP", "first", "second", "0")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as popping from the second key
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+		assert.Equal(t, []protocol.Data{
+			protocol.Array{Data: []protocol.Data{protocol.NewBulkString("RPOP"), protocol.NewBulkString("second")}},
+		}, requests(t, requestBytes))
+	})
+
+	t.Run("blmove command normalises to LMOVE", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("source", []string{"a", "b"})
+		require.NoError(t, err)
+
+		cmd := validate(t, "BLMOVE", "source", "destination", "left", "right", "0")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Equal(t, []protocol.Data{
+			protocol.Array{Data: []protocol.Data{
+				protocol.NewBulkString("LMOVE"),
+				protocol.NewBulkString("source"),
+				protocol.NewBulkString("destination"),
+				protocol.NewBulkString("LEFT"),
+				protocol.NewBulkString("RIGHT"),
+			}},
+		}, requests(t, requestBytes))
+	})
+
+	t.Run("blmpop command normalises to LPOP with the count of the key which was popped", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("second", []string{"a", "b"})
+		require.NoError(t, err)
+
+		cmd := validate(t, "BLMPOP", "0", "2", "first", "second", "LEFT", "COUNT", "3")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Equal(t, []protocol.Data{
+			protocol.Array{Data: []protocol.Data{
+				protocol.NewBulkString("LPOP"),
+				protocol.NewBulkString("second"),
+				protocol.NewBulkString("3"),
+			}},
+		}, requests(t, requestBytes))
+	})
+
+	t.Run("blpop command of missing keys is blocked and not recorded", func(t *testing.T) {
+		s := store.New()
+
+		cmd := validate(t, "BLPOP", "key", "0")
+		_, err := cmd.Execute(s)
+		assert.ErrorIs(t, err, command.ErrorBlocked)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
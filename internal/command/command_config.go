@@ -1,18 +1,50 @@
 package command
 
 import (
+	"fmt"
+	"redis-challenge/internal/glob"
 	"redis-challenge/internal/protocol"
 	"redis-challenge/internal/store"
+	"strings"
 )
 
+// notifyKeyspaceEvents is the name of the setting selecting the keyspace events that are notified.
+const notifyKeyspaceEvents = "notify-keyspace-events"
+
 type ConfigValidator struct{}
 
-func (ConfigValidator) Validate(requestBytes []byte, _ []protocol.Data) (Command, protocol.Data) {
-	return ConfigCommand{requestBytes: requestBytes}, nil
+func (ConfigValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	cmd := ConfigCommand{requestBytes: requestBytes}
+	if len(values) == 0 {
+		return cmd, nil
+	}
+
+	switch cmd.subcommand = strings.ToUpper(values[0]); cmd.subcommand {
+	case "GET":
+		if len(values) < 2 {
+			return nil, NewWrongNumberOfArgumentsError("config|get")
+		}
+	case "SET":
+		if len(values) < 3 || len(values)%2 == 0 {
+			return nil, NewWrongNumberOfArgumentsError("config|set")
+		}
+	}
+	cmd.arguments = values[1:]
+
+	return cmd, nil
 }
 
+// ConfigCommand reads and changes the settings of the server, of which only notify-keyspace-events is known. Other
+// subcommands are accepted without doing anything, so clients that configure the server on connecting still work.
 type ConfigCommand struct {
 	requestBytes []byte
+	subcommand   string
+	arguments    []string
 }
 
 func (cmd ConfigCommand) Request() ([]byte, Type) {
@@ -20,5 +52,44 @@ func (cmd ConfigCommand) Request() ([]byte, Type) {
 }
 
 func (cmd ConfigCommand) Execute(_ store.Store) (protocol.Data, error) {
-	return nil, nil
+	return nil, ErrorRequiresDatabases
+}
+
+func (cmd ConfigCommand) ExecuteOnDatabases(databases *store.Databases, _ int) (protocol.Data, error) {
+	events := databases.KeyspaceEvents()
+
+	switch cmd.subcommand {
+	case "GET":
+		var entries []protocol.MapEntry
+		for _, pattern := range cmd.arguments {
+			if glob.Match(strings.ToLower(pattern), notifyKeyspaceEvents) {
+				entries = []protocol.MapEntry{{
+					Key:   protocol.NewBulkString(notifyKeyspaceEvents),
+					Value: protocol.NewBulkString(events.Flags()),
+				}}
+			}
+		}
+		return protocol.NewMap(entries), nil
+
+	case "SET":
+		for i := 0; i < len(cmd.arguments); i += 2 {
+			if name := strings.ToLower(cmd.arguments[i]); name != notifyKeyspaceEvents {
+				return protocol.NewSimpleError(fmt.Sprintf("ERR Unknown option or number of arguments for CONFIG SET - '%s'", cmd.arguments[i])), nil
+			}
+		}
+		// every value is checked before any is set, so a failed request changes nothing
+		checked := store.NewKeyspaceEvents(nil)
+		for i := 1; i < len(cmd.arguments); i += 2 {
+			if err := checked.SetFlags(cmd.arguments[i]); err != nil {
+				return protocol.NewSimpleError(fmt.Sprintf(
+					"ERR CONFIG SET failed (possibly related to argument '%s') - Invalid event class character. Use 'Ag$lshzxeKEtmdn'.",
+					notifyKeyspaceEvents)), nil
+			}
+		}
+		_ = events.SetFlags(checked.Flags())
+		return protocol.NewSimpleString("OK"), nil
+
+	default:
+		return nil, nil
+	}
 }
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type CopyValidator struct{}
+
+func (CopyValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("copy")
+	}
+
+	cmd := CopyCommand{requestBytes: requestBytes, source: values[0], destination: values[1]}
+
+	options := values[2:]
+	for i := 0; i < len(options); i++ {
+		switch strings.ToUpper(options[i]) {
+		case "REPLACE":
+			cmd.replace = true
+		case "DB":
+			if i+1 == len(options) {
+				return nil, NewSyntaxError()
+			}
+			i++
+			index, errorData := parseDatabaseIndex(options[i])
+			if errorData != nil {
+				return nil, errorData
+			}
+			cmd.index = index
+			cmd.toOtherDatabase = true
+		default:
+			return nil, NewSyntaxError()
+		}
+	}
+
+	if cmd.source == cmd.destination && !cmd.toOtherDatabase {
+		return nil, newSameObjectsError()
+	}
+
+	return cmd, nil
+}
+
+// CopyCommand copies the value and expiry of a key to another key, which may be in another database, only
+// replacing an existing key with REPLACE.
+type CopyCommand struct {
+	requestBytes    []byte
+	source          string
+	destination     string
+	replace         bool
+	index           int
+	toOtherDatabase bool
+}
+
+func (cmd CopyCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd CopyCommand) Execute(s store.Store) (protocol.Data, error) {
+	if s.Copy(cmd.source, cmd.destination, cmd.replace) {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
+
+func (cmd CopyCommand) ExecuteOnDatabases(databases *store.Databases, selected int) (protocol.Data, error) {
+	if !cmd.toOtherDatabase {
+		return cmd.Execute(databases.Store(selected))
+	}
+	if !isDatabaseInRange(cmd.index, databases.Count()) {
+		return NewDatabaseOutOfRangeError(), nil
+	}
+	if cmd.source == cmd.destination && cmd.index == selected {
+		return newSameObjectsError(), nil
+	}
+
+	if databases.Copy(cmd.source, selected, cmd.destination, cmd.index, cmd.replace) {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
+
+func newSameObjectsError() protocol.SimpleError {
+	return protocol.NewSimpleError("ERR source and destination objects are the same")
+}
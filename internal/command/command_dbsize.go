@@ -0,0 +1,29 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type DBSizeValidator struct{}
+
+func (DBSizeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	if len(arguments) != 0 {
+		return nil, NewWrongNumberOfArgumentsError("dbsize")
+	}
+
+	return DBSizeCommand{requestBytes: requestBytes}, nil
+}
+
+// DBSizeCommand returns the number of keys, which like Redis includes expired keys that have not yet been removed.
+type DBSizeCommand struct {
+	requestBytes []byte
+}
+
+func (cmd DBSizeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd DBSizeCommand) Execute(s store.Store) (protocol.Data, error) {
+	return protocol.NewSimpleInteger(int64(s.Size())), nil
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type DiscardValidator struct{}
+
+func (DiscardValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	if len(arguments) != 0 {
+		return nil, NewWrongNumberOfArgumentsError("discard")
+	}
+
+	return DiscardCommand{requestBytes: requestBytes}, nil
+}
+
+// DiscardCommand ends a transaction without executing the commands it queued.
+type DiscardCommand struct {
+	requestBytes []byte
+}
+
+func (cmd DiscardCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd DiscardCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd DiscardCommand) ExecuteInSession(session *Session) protocol.Data {
+	if !session.InTransaction() {
+		return protocol.NewSimpleError("ERR DISCARD without MULTI")
+	}
+
+	session.transaction = nil
+	return protocol.NewSimpleString("OK")
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ExecValidator struct{}
+
+func (ExecValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	if len(arguments) != 0 {
+		return nil, NewWrongNumberOfArgumentsError("exec")
+	}
+
+	return ExecCommand{requestBytes: requestBytes}, nil
+}
+
+// ExecCommand ends a transaction by executing the commands it queued, unless any of them failed validation.
+type ExecCommand struct {
+	requestBytes []byte
+}
+
+func (cmd ExecCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ExecCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+// Transaction ends the transaction of the session, returning the command that executes what it queued, or an error
+// if there is no transaction or a command failed to queue, in which case the transaction is discarded.
+func (cmd ExecCommand) Transaction(session *Session) (TransactionCommand, protocol.Data) {
+	if !session.InTransaction() {
+		return TransactionCommand{}, protocol.NewSimpleError("ERR EXEC without MULTI")
+	}
+
+	queued, failed := session.transaction.queued, session.transaction.failed
+	session.transaction = nil
+	if failed {
+		return TransactionCommand{}, protocol.NewSimpleError("EXECABORT Transaction discarded because of previous errors.")
+	}
+
+	return TransactionCommand{session: session, queued: queued}, nil
+}
@@ -0,0 +1,185 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+// ExpireValidator validates the EXPIRE family, where the expiry is relative to now unless absolute, and in seconds
+// unless in milliseconds.
+type ExpireValidator struct {
+	clock          store.Clock
+	name           string
+	inMilliseconds bool
+	absolute       bool
+}
+
+func (v ExpireValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError(v.name)
+	}
+
+	condition, errorData := parseExpiryCondition(values[2:])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	expiry, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	cmd := &ExpireCommand{
+		clock:     v.clock,
+		name:      v.name,
+		key:       values[0],
+		relative:  !v.absolute,
+		condition: condition,
+	}
+
+	milliseconds, ok := v.milliseconds(expiry)
+	if !ok {
+		return nil, cmd.invalidExpiryError()
+	}
+	cmd.expiry = milliseconds
+
+	// a relative expiry which overflows now overflows whenever it is executed
+	if _, ok := cmd.resolve(); !ok {
+		return nil, cmd.invalidExpiryError()
+	}
+	return cmd, nil
+}
+
+// milliseconds converts the expiry to milliseconds, returning false if it overflows.
+func (v ExpireValidator) milliseconds(expiry int64) (int64, bool) {
+	if !v.inMilliseconds {
+		if expiry > math.MaxInt64/1000 || expiry < math.MinInt64/1000 {
+			return 0, false
+		}
+		expiry *= 1000
+	}
+	return expiry, true
+}
+
+// expiryCondition limits when the expiry of a key is changed, based on its current expiry.
+type expiryCondition struct {
+	onlyWithoutExpiry bool
+	onlyWithExpiry    bool
+	onlyGreater       bool
+	onlyLess          bool
+}
+
+func parseExpiryCondition(options []string) (expiryCondition, protocol.Data) {
+	var condition expiryCondition
+	for _, option := range options {
+		switch strings.ToUpper(option) {
+		case "NX":
+			condition.onlyWithoutExpiry = true
+		case "XX":
+			condition.onlyWithExpiry = true
+		case "GT":
+			condition.onlyGreater = true
+		case "LT":
+			condition.onlyLess = true
+		default:
+			return expiryCondition{}, protocol.NewSimpleError(fmt.Sprintf("ERR Unsupported option %s", option))
+		}
+	}
+
+	if condition.onlyWithoutExpiry && (condition.onlyWithExpiry || condition.onlyGreater || condition.onlyLess) {
+		return expiryCondition{}, protocol.NewSimpleError("ERR NX and XX, GT or LT options at the same time are not compatible")
+	}
+	if condition.onlyGreater && condition.onlyLess {
+		return expiryCondition{}, protocol.NewSimpleError("ERR GT and LT options at the same time are not compatible")
+	}
+	return condition, nil
+}
+
+// allows returns true if the expiry can be changed to the timestamp, where a key without an expiry is treated as
+// never expiring.
+func (c expiryCondition) allows(current int64, hasExpiry bool, timestamp int64) bool {
+	switch {
+	case c.onlyWithoutExpiry && hasExpiry:
+		return false
+	case c.onlyWithExpiry && !hasExpiry:
+		return false
+	case c.onlyGreater && (!hasExpiry || timestamp <= current):
+		return false
+	case c.onlyLess && hasExpiry && timestamp >= current:
+		return false
+	}
+	return true
+}
+
+// ExpireCommand is logged with the absolute time it sets, or as a DEL if that time has already passed, so that
+// replaying it does not depend on when it is replayed. A relative expiry counts from when the command is executed,
+// which is later than it was validated for a command queued in a transaction.
+type ExpireCommand struct {
+	clock     store.Clock
+	name      string
+	key       string
+	expiry    int64
+	relative  bool
+	condition expiryCondition
+	timestamp int64
+	updated   bool
+	deleted   bool
+}
+
+// resolve returns the time in milliseconds when the key is to expire, returning false if it overflows.
+func (cmd *ExpireCommand) resolve() (int64, bool) {
+	if !cmd.relative {
+		return cmd.expiry, true
+	}
+	return expiryFromNow(cmd.clock, cmd.expiry)
+}
+
+func (cmd *ExpireCommand) invalidExpiryError() protocol.Data {
+	return protocol.NewSimpleError(fmt.Sprintf("ERR invalid expire time in '%s' command", cmd.name))
+}
+
+func (cmd *ExpireCommand) Request() ([]byte, Type) {
+	switch {
+	case !cmd.updated:
+		return nil, TypeUpdate
+	case cmd.deleted:
+		return encodeRequest("DEL", cmd.key), TypeUpdate
+	default:
+		return encodeRequest("PEXPIREAT", cmd.key, strconv.FormatInt(cmd.timestamp, 10)), TypeUpdate
+	}
+}
+
+func (cmd *ExpireCommand) Execute(s store.Store) (protocol.Data, error) {
+	timestamp, ok := cmd.resolve()
+	if !ok {
+		return cmd.invalidExpiryError(), nil
+	}
+
+	current, hasExpiry, err := s.Expiry(cmd.key)
+	if errors.Is(err, store.ErrorKeyNotFound) {
+		return protocol.NewSimpleInteger(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.condition.allows(current, hasExpiry, timestamp) {
+		return protocol.NewSimpleInteger(0), nil
+	}
+
+	s.SetExpiry(cmd.key, timestamp)
+	cmd.timestamp = timestamp
+	cmd.updated = true
+	cmd.deleted = !s.Exists(cmd.key)
+	return protocol.NewSimpleInteger(1), nil
+}
@@ -0,0 +1,112 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandExpire(t *testing.T) {
+
+	clock := &store.FixedClock{TimeInMilliseconds: 10_000}
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(clock).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	t.Run("expire command normalises to PEXPIREAT with the absolute time in milliseconds", func(t *testing.T) {
+		// Given a key
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		// When we set a relative expiry in seconds
+		cmd := validate(t, "EXPIRE", "key", "5")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded with the time the key expires
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("PEXPIREAT"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("15000"),
+		}}, validatedRequest)
+	})
+
+	t.Run("relative expire command counts from when it is executed", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 10_000}
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		// When the command is executed some time after it is validated, as it is when queued in a transaction
+		cmd, errorData := command.NewValidator(clock).Validate(nil, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("PEXPIRE"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("500"),
+		}})
+		require.Nil(t, errorData)
+		clock.AddMilliseconds(2_000)
+
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the key expires relative to the time of execution
+		expiry, _, err := s.Expiry("key")
+		require.NoError(t, err)
+		assert.Equal(t, int64(12_500), expiry)
+
+		requestBytes, _ := cmd.Request()
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("PEXPIREAT"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("12500"),
+		}}, validatedRequest)
+	})
+
+	t.Run("expire command in the past normalises to DEL", func(t *testing.T) {
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		cmd := validate(t, "EXPIREAT", "key", "1")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("DEL"),
+			protocol.NewBulkString("key"),
+		}}, validatedRequest)
+	})
+
+	t.Run("expire command that does not meet its condition is not recorded", func(t *testing.T) {
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		cmd := validate(t, "PEXPIRE", "key", "5000", "XX")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
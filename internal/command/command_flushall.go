@@ -0,0 +1,39 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type FlushAllValidator struct{}
+
+func (FlushAllValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if errorData := parseFlushMode(values); errorData != nil {
+		return nil, errorData
+	}
+
+	return FlushAllCommand{requestBytes: requestBytes}, nil
+}
+
+// FlushAllCommand removes every key from every database.
+type FlushAllCommand struct {
+	requestBytes []byte
+}
+
+func (cmd FlushAllCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd FlushAllCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresDatabases
+}
+
+func (cmd FlushAllCommand) ExecuteOnDatabases(databases *store.Databases, _ int) (protocol.Data, error) {
+	databases.FlushAll()
+	return protocol.NewSimpleString("OK"), nil
+}
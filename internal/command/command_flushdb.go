@@ -0,0 +1,50 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+// parseFlushMode accepts the ASYNC and SYNC options of the flush commands. Both flush at once, as the memory of the
+// flushed keys is left for the garbage collector to reclaim in the background, which is what ASYNC asks for.
+func parseFlushMode(values []string) protocol.Data {
+	if len(values) > 1 {
+		return NewSyntaxError()
+	}
+	if len(values) == 1 {
+		if mode := strings.ToUpper(values[0]); mode != "ASYNC" && mode != "SYNC" {
+			return NewSyntaxError()
+		}
+	}
+	return nil
+}
+
+type FlushDBValidator struct{}
+
+func (FlushDBValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if errorData := parseFlushMode(values); errorData != nil {
+		return nil, errorData
+	}
+
+	return FlushDBCommand{requestBytes: requestBytes}, nil
+}
+
+// FlushDBCommand removes every key from the selected database.
+type FlushDBCommand struct {
+	requestBytes []byte
+}
+
+func (cmd FlushDBCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd FlushDBCommand) Execute(s store.Store) (protocol.Data, error) {
+	s.Flush()
+	return protocol.NewSimpleString("OK"), nil
+}
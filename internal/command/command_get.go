@@ -36,6 +36,9 @@ func (cmd GetCommand) Execute(s store.Store) (protocol.Data, error) {
 	if errors.Is(err, store.ErrorKeyNotFound) {
 		return nil, nil
 	}
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
 	if err != nil {
 		return nil, err
 	}
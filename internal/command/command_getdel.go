@@ -0,0 +1,48 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type GetDelValidator struct{}
+
+func (GetDelValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("getdel")
+	}
+
+	return GetDelCommand{requestBytes: requestBytes, key: values[0]}, nil
+}
+
+// GetDelCommand reads the string at the key and deletes the key.
+type GetDelCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd GetDelCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd GetDelCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.ReadString(cmd.key)
+
+	switch {
+	case errors.Is(err, store.ErrorKeyNotFound):
+		return nil, nil
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case err != nil:
+		return nil, err
+	}
+
+	s.Delete(cmd.key)
+	return protocol.NewBulkString(value), nil
+}
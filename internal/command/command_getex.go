@@ -0,0 +1,115 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type GetExValidator struct {
+	clock store.Clock
+}
+
+func (v GetExValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) == 0 {
+		return nil, NewWrongNumberOfArgumentsError("getex")
+	}
+
+	cmd := &GetExCommand{clock: v.clock, key: values[0]}
+
+	options := values[1:]
+	for i := 0; i < len(options); i++ {
+		option := store.ExpiryOption(strings.ToUpper(options[i]))
+		switch option {
+		case store.ExpiryOptionExpirySeconds, store.ExpiryOptionExpiryMilliseconds,
+			store.ExpiryOptionExpiryUnixTimeInSeconds, store.ExpiryOptionExpiryUnixTimeInMilliseconds:
+			if cmd.hasExpiry || cmd.persist || i+1 == len(options) {
+				return nil, NewSyntaxError()
+			}
+			i++
+			expiry, errorData := parsePositiveExpiry(v.clock, "getex", option, options[i])
+			if errorData != nil {
+				return nil, errorData
+			}
+			cmd.hasExpiry = true
+			cmd.expiry = expiry
+			cmd.relative = isRelativeExpiry(option)
+		case "PERSIST":
+			if cmd.hasExpiry || cmd.persist {
+				return nil, NewSyntaxError()
+			}
+			cmd.persist = true
+		default:
+			return nil, NewSyntaxError()
+		}
+	}
+
+	return cmd, nil
+}
+
+// GetExCommand reads the string at the key and changes its expiry, being logged as the change to the expiry with
+// the absolute time the key expires. A relative expiry counts from when the command is executed.
+type GetExCommand struct {
+	clock     store.Clock
+	key       string
+	hasExpiry bool
+	expiry    int64
+	relative  bool
+	timestamp int64
+	persist   bool
+	updated   bool
+	deleted   bool
+}
+
+func (cmd *GetExCommand) Request() ([]byte, Type) {
+	switch {
+	case !cmd.updated:
+		return nil, TypeUpdate
+	case cmd.deleted:
+		return encodeRequest("DEL", cmd.key), TypeUpdate
+	case cmd.persist:
+		return encodeRequest("PERSIST", cmd.key), TypeUpdate
+	default:
+		return encodeRequest("PEXPIREAT", cmd.key, strconv.FormatInt(cmd.timestamp, 10)), TypeUpdate
+	}
+}
+
+func (cmd *GetExCommand) Execute(s store.Store) (protocol.Data, error) {
+	timestamp := cmd.expiry
+	if cmd.hasExpiry && cmd.relative {
+		var ok bool
+		if timestamp, ok = expiryFromNow(cmd.clock, cmd.expiry); !ok {
+			return protocol.NewSimpleError("ERR invalid expire time in 'getex' command"), nil
+		}
+	}
+
+	value, err := s.ReadString(cmd.key)
+
+	switch {
+	case errors.Is(err, store.ErrorKeyNotFound):
+		return nil, nil
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case err != nil:
+		return nil, err
+	}
+
+	switch {
+	case cmd.hasExpiry:
+		s.SetExpiry(cmd.key, timestamp)
+		cmd.timestamp = timestamp
+		cmd.updated = true
+		cmd.deleted = !s.Exists(cmd.key)
+	case cmd.persist:
+		cmd.updated = s.Persist(cmd.key)
+	}
+
+	return protocol.NewBulkString(value), nil
+}
@@ -0,0 +1,126 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandsWithRelativeExpiry(t *testing.T) {
+
+	clock := &store.FixedClock{TimeInMilliseconds: 10_123}
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(clock).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	requestOf := func(cmd command.Command) protocol.Data {
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		return validatedRequest
+	}
+
+	t.Run("setex command normalises to SET with PXAT", func(t *testing.T) {
+		cmd := validate(t, "SETEX", "key", "3", "value")
+		_, err := cmd.Execute(store.NewWithClock(clock))
+		require.NoError(t, err)
+
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("SET"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("value"),
+			protocol.NewBulkString("PXAT"),
+			protocol.NewBulkString("13123"),
+		}}, requestOf(cmd))
+	})
+
+	t.Run("psetex command normalises to SET with PXAT", func(t *testing.T) {
+		cmd := validate(t, "PSETEX", "key", "3", "value")
+		_, err := cmd.Execute(store.NewWithClock(clock))
+		require.NoError(t, err)
+
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("SET"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("value"),
+			protocol.NewBulkString("PXAT"),
+			protocol.NewBulkString("10126"),
+		}}, requestOf(cmd))
+	})
+
+	t.Run("getex command with relative expiry normalises to PEXPIREAT", func(t *testing.T) {
+		// Given a string
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		// When we read it and set its expiry
+		cmd := validate(t, "GETEX", "key", "EX", "3")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as setting the absolute expiry
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("PEXPIREAT"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("13123"),
+		}}, requestOf(cmd))
+	})
+
+	t.Run("getex command with relative expiry counts from when it is executed", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 10_123}
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		// When the command is executed some time after it is validated, as it is when queued in a transaction
+		cmd, errorData := command.NewValidator(clock).Validate(nil, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("GETEX"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("PX"),
+			protocol.NewBulkString("500"),
+		}})
+		require.Nil(t, errorData)
+		clock.AddMilliseconds(2_000)
+
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the key expires relative to the time of execution
+		expiry, _, err := s.Expiry("key")
+		require.NoError(t, err)
+		assert.Equal(t, int64(12_623), expiry)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("PEXPIREAT"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("12623"),
+		}}, requestOf(cmd))
+	})
+
+	t.Run("getex command without options is not recorded", func(t *testing.T) {
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		cmd := validate(t, "GETEX", "key")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
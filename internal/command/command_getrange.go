@@ -0,0 +1,84 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type GetRangeValidator struct{}
+
+func (GetRangeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("getrange")
+	}
+
+	start, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	end, err := strconv.ParseInt(values[2], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return GetRangeCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		start:        start,
+		end:          end,
+	}, nil
+}
+
+// GetRangeCommand reads the bytes of the string from the start to the end index inclusive, where negative indexes
+// count back from the end of the string.
+type GetRangeCommand struct {
+	requestBytes []byte
+	key          string
+	start        int64
+	end          int64
+}
+
+func (cmd GetRangeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd GetRangeCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.ReadString(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil && !errors.Is(err, store.ErrorKeyNotFound) {
+		return nil, err
+	}
+
+	return protocol.NewBulkString(substring(value, cmd.start, cmd.end)), nil
+}
+
+// substring returns the bytes from the start to the end index inclusive, following the clamping of GETRANGE.
+func substring(value string, start int64, end int64) string {
+	length := int64(len(value))
+	if start < 0 && end < 0 && start > end {
+		return ""
+	}
+
+	if start < 0 {
+		start = max(length+start, 0)
+	}
+	if end < 0 {
+		end = max(length+end, 0)
+	}
+	end = min(end, length-1)
+
+	if start > end || length == 0 {
+		return ""
+	}
+	return value[start : end+1]
+}
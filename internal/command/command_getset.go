@@ -0,0 +1,27 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type GetSetValidator struct{}
+
+func (GetSetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("getset")
+	}
+
+	return SetCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		value:        values[1],
+		get:          true,
+		expiryOption: store.ExpiryOptionNone,
+	}, nil
+}
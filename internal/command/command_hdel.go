@@ -0,0 +1,49 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HDelValidator struct{}
+
+func (HDelValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("hdel")
+	}
+
+	return HDelCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		fields:       values[1:],
+	}, nil
+}
+
+type HDelCommand struct {
+	requestBytes []byte
+	key          string
+	fields       []string
+}
+
+func (cmd HDelCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd HDelCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.HashDelete(cmd.key, cmd.fields)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(count), nil
+}
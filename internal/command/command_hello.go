@@ -0,0 +1,107 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+const serverVersion = "7.4.0"
+
+var ErrorRequiresSession = errors.New("command must be executed in a connection session")
+
+type HelloValidator struct{}
+
+func (HelloValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values := make([]string, len(arguments))
+	for i, arg := range arguments {
+		if _, ok := arguments[i].(protocol.BulkString); ok {
+			values[i] = string(arg.(protocol.BulkString))
+			continue
+		}
+
+		return nil, NewWrongDataTypeError(arguments[i], protocol.BulkStringSymbol)
+	}
+
+	cmd := HelloCommand{requestBytes: requestBytes}
+
+	if len(values) == 0 {
+		return cmd, nil
+	}
+
+	version, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR Protocol version is not an integer or out of range")
+	}
+	if version != int64(protocol.Version2) && version != int64(protocol.Version3) {
+		return nil, protocol.NewSimpleError("NOPROTO unsupported protocol version")
+	}
+	cmd.version = protocol.Version(version)
+
+	for i := 1; i < len(values); i++ {
+		remainingCount := len(values) - i - 1
+
+		switch option := strings.ToUpper(values[i]); {
+		case option == "AUTH" && remainingCount >= 2:
+			if values[i+1] != "default" {
+				return nil, protocol.NewSimpleError("WRONGPASS invalid username-password pair or user is disabled.")
+			}
+			i += 2
+		case option == "SETNAME" && remainingCount >= 1:
+			if !isValidClientName(values[i+1]) {
+				return nil, protocol.NewSimpleError("ERR Client names cannot contain spaces, newlines or special characters.")
+			}
+			cmd.name = values[i+1]
+			i++
+		default:
+			return nil, protocol.NewSimpleError(fmt.Sprintf("ERR Syntax error in HELLO option '%s'", values[i]))
+		}
+	}
+
+	return cmd, nil
+}
+
+func isValidClientName(name string) bool {
+	for _, c := range []byte(name) {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+type HelloCommand struct {
+	requestBytes []byte
+	version      protocol.Version
+	name         string
+}
+
+func (cmd HelloCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HelloCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd HelloCommand) ExecuteInSession(session *Session) protocol.Data {
+	if cmd.version != 0 {
+		session.ProtocolVersion = cmd.version
+	}
+	if cmd.name != "" {
+		session.Name = cmd.name
+	}
+
+	return protocol.NewMap([]protocol.MapEntry{
+		{Key: protocol.NewBulkString("server"), Value: protocol.NewBulkString("redis")},
+		{Key: protocol.NewBulkString("version"), Value: protocol.NewBulkString(serverVersion)},
+		{Key: protocol.NewBulkString("proto"), Value: protocol.NewSimpleInteger(int64(session.ProtocolVersion))},
+		{Key: protocol.NewBulkString("id"), Value: protocol.NewSimpleInteger(session.Id)},
+		{Key: protocol.NewBulkString("mode"), Value: protocol.NewBulkString("standalone")},
+		{Key: protocol.NewBulkString("role"), Value: protocol.NewBulkString("master")},
+		{Key: protocol.NewBulkString("modules"), Value: protocol.NewArray(nil)},
+	})
+}
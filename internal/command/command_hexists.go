@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HExistsValidator struct{}
+
+func (HExistsValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("hexists")
+	}
+
+	return HExistsCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		field:        values[1],
+	}, nil
+}
+
+type HExistsCommand struct {
+	requestBytes []byte
+	key          string
+	field        string
+}
+
+func (cmd HExistsCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HExistsCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := h.Get(cmd.field); ok {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HGetValidator struct{}
+
+func (HGetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("hget")
+	}
+
+	return HGetCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		field:        values[1],
+	}, nil
+}
+
+type HGetCommand struct {
+	requestBytes []byte
+	key          string
+	field        string
+}
+
+func (cmd HGetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HGetCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok := h.Get(cmd.field); ok {
+		return protocol.NewBulkString(value), nil
+	}
+	return nil, nil
+}
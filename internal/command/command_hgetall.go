@@ -0,0 +1,54 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HGetAllValidator struct{}
+
+func (HGetAllValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("hgetall")
+	}
+
+	return HGetAllCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type HGetAllCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd HGetAllCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HGetAllCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]protocol.MapEntry, 0, h.Len())
+	for field, value := range h.Entries() {
+		entries = append(entries, protocol.MapEntry{
+			Key:   protocol.NewBulkString(field),
+			Value: protocol.NewBulkString(value),
+		})
+	}
+	return protocol.NewMap(entries), nil
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type HIncrByValidator struct{}
+
+func (HIncrByValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("hincrby")
+	}
+
+	increment, err := strconv.ParseInt(values[2], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return HIncrByCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		field:        values[1],
+		increment:    increment,
+	}, nil
+}
+
+type HIncrByCommand struct {
+	requestBytes []byte
+	key          string
+	field        string
+	increment    int64
+}
+
+func (cmd HIncrByCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd HIncrByCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.HashIncrement(cmd.key, cmd.field, cmd.increment)
+
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case errors.Is(err, store.ErrorNotAnInteger):
+		return protocol.NewSimpleError("ERR hash value is not an integer"), nil
+	case errors.Is(err, store.ErrorOverflow):
+		return protocol.NewSimpleError("ERR increment or decrement would overflow"), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(value), nil
+}
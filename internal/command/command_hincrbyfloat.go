@@ -0,0 +1,68 @@
+package command
+
+import (
+	"errors"
+	"math/big"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HIncrByFloatValidator struct{}
+
+func (HIncrByFloatValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("hincrbyfloat")
+	}
+
+	increment, ok := store.ParseLongDouble(values[2])
+	if !ok {
+		return nil, protocol.NewSimpleError("ERR value is not a valid float")
+	}
+
+	return &HIncrByFloatCommand{
+		key:       values[0],
+		field:     values[1],
+		increment: increment,
+	}, nil
+}
+
+// HIncrByFloatCommand is logged as an HSET of the resulting value, so that replaying it does not depend on the
+// rounding of the increment.
+type HIncrByFloatCommand struct {
+	key       string
+	field     string
+	increment *big.Float
+	result    string
+	updated   bool
+}
+
+func (cmd *HIncrByFloatCommand) Request() ([]byte, Type) {
+	if !cmd.updated {
+		return nil, TypeUpdate
+	}
+	return encodeRequest("HSET", cmd.key, cmd.field, cmd.result), TypeUpdate
+}
+
+func (cmd *HIncrByFloatCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.HashIncrementFloat(cmd.key, cmd.field, cmd.increment)
+
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case errors.Is(err, store.ErrorNotAFloat):
+		return protocol.NewSimpleError("ERR hash value is not a float"), nil
+	case errors.Is(err, store.ErrorNotANumberOrInfinity):
+		return protocol.NewSimpleError("ERR increment would produce NaN or Infinity"), nil
+	case err != nil:
+		return nil, err
+	}
+
+	cmd.result = value
+	cmd.updated = true
+	return protocol.NewBulkString(value), nil
+}
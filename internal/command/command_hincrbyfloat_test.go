@@ -0,0 +1,67 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/hash"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandHIncrByFloat(t *testing.T) {
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{Data: []protocol.Data{protocol.NewBulkString("HINCRBYFLOAT")}}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(&store.FixedClock{}).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	t.Run("hincrbyfloat command normalises to HSET of the result", func(t *testing.T) {
+		// Given a number in a field of the hash
+		s := store.New()
+		_, err := s.HashSet("key", []hash.Entry{{Field: "field", Value: "10.5"}})
+		require.NoError(t, err)
+
+		// When we increment it
+		cmd := validate(t, "key", "field", "0.1")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as setting the result
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("HSET"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("field"),
+			protocol.NewBulkString("10.6"),
+		}}, validatedRequest)
+	})
+
+	t.Run("hincrbyfloat command of a value that is not a float is not recorded", func(t *testing.T) {
+		s := store.New()
+		_, err := s.HashSet("key", []hash.Entry{{Field: "field", Value: "ten"}})
+		require.NoError(t, err)
+
+		cmd := validate(t, "key", "field", "1")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
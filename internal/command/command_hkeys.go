@@ -0,0 +1,51 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HKeysValidator struct{}
+
+func (HKeysValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("hkeys")
+	}
+
+	return HKeysCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type HKeysCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd HKeysCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HKeysCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]protocol.Data, 0, h.Len())
+	for field := range h.Entries() {
+		fields = append(fields, protocol.NewBulkString(field))
+	}
+	return protocol.NewArray(fields), nil
+}
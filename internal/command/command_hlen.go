@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HLenValidator struct{}
+
+func (HLenValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("hlen")
+	}
+
+	return HLenCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type HLenCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd HLenCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HLenCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(h.Len())), nil
+}
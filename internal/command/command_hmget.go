@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HMGetValidator struct{}
+
+func (HMGetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("hmget")
+	}
+
+	return HMGetCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		fields:       values[1:],
+	}, nil
+}
+
+type HMGetCommand struct {
+	requestBytes []byte
+	key          string
+	fields       []string
+}
+
+func (cmd HMGetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HMGetCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]protocol.Data, len(cmd.fields))
+	for i, field := range cmd.fields {
+		if value, ok := h.Get(field); ok {
+			values[i] = protocol.NewBulkString(value)
+		}
+	}
+	return protocol.NewArray(values), nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HScanValidator struct{}
+
+func (HScanValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("hscan")
+	}
+
+	query, errorData := parseScanQuery(values[1:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return HScanCommand{requestBytes: requestBytes, key: values[0], query: query}, nil
+}
+
+// HScanCommand returns a page of the fields of a hash from a cursor, each followed by its value.
+type HScanCommand struct {
+	requestBytes []byte
+	key          string
+	query        scanQuery
+}
+
+func (cmd HScanCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HScanCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	next, fields := h.Scan(cmd.query.cursor, cmd.query.count)
+
+	found := make([]protocol.Data, 0, 2*len(fields))
+	for _, field := range fields {
+		if cmd.query.matches(field) {
+			value, _ := h.Get(field)
+			found = append(found, protocol.NewBulkString(field), protocol.NewBulkString(value))
+		}
+	}
+	return newScanData(next, protocol.NewArray(found)), nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/hash"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HSetValidator struct{}
+
+func (HSetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 || len(values)%2 == 0 {
+		return nil, NewWrongNumberOfArgumentsError("hset")
+	}
+
+	entries := make([]hash.Entry, 0, len(values)/2)
+	for i := 1; i < len(values); i += 2 {
+		entries = append(entries, hash.Entry{Field: values[i], Value: values[i+1]})
+	}
+
+	return HSetCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		entries:      entries,
+	}, nil
+}
+
+type HSetCommand struct {
+	requestBytes []byte
+	key          string
+	entries      []hash.Entry
+}
+
+func (cmd HSetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd HSetCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.HashSet(cmd.key, cmd.entries)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(count), nil
+}
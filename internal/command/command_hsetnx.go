@@ -0,0 +1,54 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HSetNxValidator struct{}
+
+func (HSetNxValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("hsetnx")
+	}
+
+	return HSetNxCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		field:        values[1],
+		value:        values[2],
+	}, nil
+}
+
+type HSetNxCommand struct {
+	requestBytes []byte
+	key          string
+	field        string
+	value        string
+}
+
+func (cmd HSetNxCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd HSetNxCommand) Execute(s store.Store) (protocol.Data, error) {
+	added, err := s.HashSetIfMissing(cmd.key, cmd.field, cmd.value)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if added {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
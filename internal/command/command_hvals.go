@@ -0,0 +1,51 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type HValsValidator struct{}
+
+func (HValsValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("hvals")
+	}
+
+	return HValsCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type HValsCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd HValsCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd HValsCommand) Execute(s store.Store) (protocol.Data, error) {
+	h, err := s.ReadHash(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]protocol.Data, 0, h.Len())
+	for _, value := range h.Entries() {
+		values = append(values, protocol.NewBulkString(value))
+	}
+	return protocol.NewArray(values), nil
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"math"
+	"redis-challenge/internal/protocol"
+	"strconv"
+)
+
+type IncrByValidator struct {
+	decrement bool
+}
+
+func (v IncrByValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		if v.decrement {
+			return nil, NewWrongNumberOfArgumentsError("decrby")
+		}
+		return nil, NewWrongNumberOfArgumentsError("incrby")
+	}
+
+	change, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	if v.decrement {
+		if change == math.MinInt64 {
+			return nil, protocol.NewSimpleError("ERR decrement would overflow")
+		}
+		change = -change
+	}
+
+	return ChangeIntegerCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		change:       change,
+	}, nil
+}
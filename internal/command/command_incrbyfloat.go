@@ -0,0 +1,66 @@
+package command
+
+import (
+	"errors"
+	"math/big"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type IncrByFloatValidator struct{}
+
+func (IncrByFloatValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("incrbyfloat")
+	}
+
+	increment, ok := store.ParseLongDouble(values[1])
+	if !ok {
+		return nil, protocol.NewSimpleError("ERR value is not a valid float")
+	}
+
+	return &IncrByFloatCommand{
+		key:       values[0],
+		increment: increment,
+	}, nil
+}
+
+// IncrByFloatCommand is logged as a SET of the resulting value, so that replaying it does not depend on the
+// rounding of the increment.
+type IncrByFloatCommand struct {
+	key       string
+	increment *big.Float
+	result    string
+	updated   bool
+}
+
+func (cmd *IncrByFloatCommand) Request() ([]byte, Type) {
+	if !cmd.updated {
+		return nil, TypeUpdate
+	}
+	return encodeRequest("SET", cmd.key, cmd.result, "KEEPTTL"), TypeUpdate
+}
+
+func (cmd *IncrByFloatCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.IncrementFloat(cmd.key, cmd.increment)
+
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case errors.Is(err, store.ErrorNotAFloat):
+		return protocol.NewSimpleError("ERR value is not a valid float"), nil
+	case errors.Is(err, store.ErrorNotANumberOrInfinity):
+		return protocol.NewSimpleError("ERR increment would produce NaN or Infinity"), nil
+	case err != nil:
+		return nil, err
+	}
+
+	cmd.result = value
+	cmd.updated = true
+	return protocol.NewBulkString(value), nil
+}
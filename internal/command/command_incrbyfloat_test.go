@@ -0,0 +1,64 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandIncrByFloat(t *testing.T) {
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{Data: []protocol.Data{protocol.NewBulkString("INCRBYFLOAT")}}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(&store.FixedClock{}).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	t.Run("incrbyfloat command normalises to SET of the result keeping the expiry", func(t *testing.T) {
+		// Given a number in the key
+		s := store.New()
+		s.Write("key", "10.5", store.ExpiryOptionNone, 0)
+
+		// When we increment it
+		cmd := validate(t, "key", "0.1")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as setting the result
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("SET"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("10.6"),
+			protocol.NewBulkString("KEEPTTL"),
+		}}, validatedRequest)
+	})
+
+	t.Run("incrbyfloat command of a value that is not a float is not recorded", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "ten", store.ExpiryOptionNone, 0)
+
+		cmd := validate(t, "key", "1")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"redis-challenge/internal/glob"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type KeysValidator struct{}
+
+func (KeysValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("keys")
+	}
+
+	return KeysCommand{requestBytes: requestBytes, pattern: values[0]}, nil
+}
+
+// KeysCommand returns the keys matching a glob-style pattern, in no particular order.
+type KeysCommand struct {
+	requestBytes []byte
+	pattern      string
+}
+
+func (cmd KeysCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd KeysCommand) Execute(s store.Store) (protocol.Data, error) {
+	keys := s.Keys()
+	if cmd.pattern != "*" {
+		matching := keys[:0]
+		for _, key := range keys {
+			if glob.Match(cmd.pattern, key) {
+				matching = append(matching, key)
+			}
+		}
+		keys = matching
+	}
+	return newBulkStringsData(keys), nil
+}
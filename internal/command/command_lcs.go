@@ -0,0 +1,213 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type LcsValidator struct{}
+
+func (LcsValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("lcs")
+	}
+
+	cmd := LcsCommand{
+		requestBytes: requestBytes,
+		firstKey:     values[0],
+		secondKey:    values[1],
+	}
+
+	options := values[2:]
+	for i := 0; i < len(options); i++ {
+		switch strings.ToUpper(options[i]) {
+		case "LEN":
+			cmd.length = true
+		case "IDX":
+			cmd.indexes = true
+		case "WITHMATCHLEN":
+			cmd.withMatchLength = true
+		case "MINMATCHLEN":
+			if i+1 == len(options) {
+				return nil, NewSyntaxError()
+			}
+			i++
+			minimum, err := strconv.ParseInt(options[i], 10, 64)
+			if err != nil {
+				return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+			}
+			cmd.minimumMatchLength = max(minimum, 0)
+		default:
+			return nil, NewSyntaxError()
+		}
+	}
+
+	if cmd.length && cmd.indexes {
+		return nil, protocol.NewSimpleError("ERR If you want both the length and indexes, please just use IDX.")
+	}
+
+	return cmd, nil
+}
+
+// LcsCommand finds the longest common subsequence of the strings at two keys, replying with the subsequence, its
+// length or the ranges of each string which match.
+type LcsCommand struct {
+	requestBytes       []byte
+	firstKey           string
+	secondKey          string
+	length             bool
+	indexes            bool
+	minimumMatchLength int64
+	withMatchLength    bool
+}
+
+func (cmd LcsCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd LcsCommand) Execute(s store.Store) (protocol.Data, error) {
+	a, err := readStringOrEmpty(s, cmd.firstKey)
+	if err != nil {
+		return nil, err
+	}
+	b, err := readStringOrEmpty(s, cmd.secondKey)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil || b == nil {
+		return protocol.NewSimpleError("ERR The specified keys must contain string values"), nil
+	}
+
+	// the table of subsequence lengths uses 4 bytes for each pair of prefixes
+	if 4*(int64(len(*a))+1)*(int64(len(*b))+1) > maximumStringLength {
+		return protocol.NewSimpleError("ERR Insufficient memory, transient memory for LCS exceeds proto-max-bulk-len"), nil
+	}
+
+	result := longestCommonSubsequence(*a, *b)
+	switch {
+	case cmd.length:
+		return protocol.NewSimpleInteger(int64(len(result.subsequence))), nil
+	case cmd.indexes:
+		return protocol.NewMap([]protocol.MapEntry{
+			{Key: protocol.NewBulkString("matches"), Value: cmd.matchesData(result.matches)},
+			{Key: protocol.NewBulkString("len"), Value: protocol.NewSimpleInteger(int64(len(result.subsequence)))},
+		}), nil
+	default:
+		return protocol.NewBulkString(result.subsequence), nil
+	}
+}
+
+func (cmd LcsCommand) matchesData(matches []lcsMatch) protocol.Data {
+	data := make([]protocol.Data, 0, len(matches))
+	for _, m := range matches {
+		if m.length() < cmd.minimumMatchLength {
+			continue
+		}
+
+		match := []protocol.Data{
+			protocol.NewArray([]protocol.Data{protocol.NewSimpleInteger(m.firstStart), protocol.NewSimpleInteger(m.firstEnd)}),
+			protocol.NewArray([]protocol.Data{protocol.NewSimpleInteger(m.secondStart), protocol.NewSimpleInteger(m.secondEnd)}),
+		}
+		if cmd.withMatchLength {
+			match = append(match, protocol.NewSimpleInteger(m.length()))
+		}
+		data = append(data, protocol.NewArray(match))
+	}
+	return protocol.NewArray(data)
+}
+
+// readStringOrEmpty returns the string at the key, an empty string if there is no key or nil if the key holds
+// another type.
+func readStringOrEmpty(s store.Store, key string) (*string, error) {
+	value, err := s.ReadString(key)
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return nil, nil
+	case errors.Is(err, store.ErrorKeyNotFound):
+		return &value, nil
+	case err != nil:
+		return nil, err
+	}
+	return &value, nil
+}
+
+// lcsMatch is a range of the first string matching a range of the second string, with inclusive indexes.
+type lcsMatch struct {
+	firstStart, firstEnd   int64
+	secondStart, secondEnd int64
+}
+
+func (m lcsMatch) length() int64 {
+	return m.firstEnd - m.firstStart + 1
+}
+
+type lcsResult struct {
+	subsequence string
+	// matches are the ranges making up the subsequence, from the end of the strings to the start
+	matches []lcsMatch
+}
+
+// longestCommonSubsequence finds the longest common subsequence of the strings by dynamic programming, walking the
+// table back from the end of both strings to collect the subsequence and the ranges it matches.
+func longestCommonSubsequence(a string, b string) lcsResult {
+	columns := len(b) + 1
+	lengths := make([]uint32, (len(a)+1)*columns)
+	at := func(i, j int) uint32 { return lengths[i*columns+j] }
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				lengths[i*columns+j] = at(i-1, j-1) + 1
+			} else {
+				lengths[i*columns+j] = max(at(i-1, j), at(i, j-1))
+			}
+		}
+	}
+
+	subsequence := make([]byte, at(len(a), len(b)))
+	remaining := len(subsequence)
+
+	var matches []lcsMatch
+	var current *lcsMatch
+	i, j := len(a), len(b)
+	for i > 0 && j > 0 {
+		emit := false
+		if a[i-1] == b[j-1] {
+			subsequence[remaining-1] = a[i-1]
+			remaining--
+
+			if current == nil {
+				current = &lcsMatch{firstStart: int64(i - 1), firstEnd: int64(i - 1), secondStart: int64(j - 1), secondEnd: int64(j - 1)}
+			} else {
+				// moving diagonally extends the current range backwards
+				current.firstStart--
+				current.secondStart--
+			}
+			emit = i == 1 || j == 1
+			i--
+			j--
+		} else {
+			if at(i-1, j) > at(i, j-1) {
+				i--
+			} else {
+				j--
+			}
+			emit = current != nil
+		}
+
+		if emit {
+			matches = append(matches, *current)
+			current = nil
+		}
+	}
+
+	return lcsResult{subsequence: string(subsequence), matches: matches}
+}
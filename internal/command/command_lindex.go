@@ -0,0 +1,58 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type LIndexValidator struct{}
+
+func (LIndexValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("lindex")
+	}
+
+	index, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return LIndexCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		index:        index,
+	}, nil
+}
+
+type LIndexCommand struct {
+	requestBytes []byte
+	key          string
+	index        int
+}
+
+func (cmd LIndexCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd LIndexCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, ok, err := s.ListIndex(cmd.key, cmd.index)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, nil
+	}
+	return protocol.NewBulkString(value), nil
+}
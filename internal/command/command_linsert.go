@@ -0,0 +1,62 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type LInsertValidator struct{}
+
+func (LInsertValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 4 {
+		return nil, NewWrongNumberOfArgumentsError("linsert")
+	}
+
+	cmd := LInsertCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		pivot:        values[2],
+		value:        values[3],
+	}
+	switch strings.ToUpper(values[1]) {
+	case "BEFORE":
+		cmd.before = true
+	case "AFTER":
+	default:
+		return nil, NewSyntaxError()
+	}
+
+	return cmd, nil
+}
+
+type LInsertCommand struct {
+	requestBytes []byte
+	key          string
+	before       bool
+	pivot        string
+	value        string
+}
+
+func (cmd LInsertCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LInsertCommand) Execute(s store.Store) (protocol.Data, error) {
+	length, err := s.ListInsert(cmd.key, cmd.pivot, cmd.value, cmd.before)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(length), nil
+}
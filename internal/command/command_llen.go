@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type LLenValidator struct{}
+
+func (LLenValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("llen")
+	}
+
+	return LLenCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type LLenCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd LLenCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd LLenCommand) Execute(s store.Store) (protocol.Data, error) {
+	length, err := s.ListLength(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(length)), nil
+}
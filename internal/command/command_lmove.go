@@ -0,0 +1,108 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type LMoveValidator struct{}
+
+func (LMoveValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 4 {
+		return nil, NewWrongNumberOfArgumentsError("lmove")
+	}
+
+	move, errorData := parseListMove(values)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return LMoveCommand{requestBytes: requestBytes, listMove: move}, nil
+}
+
+// parseListMove parses the source destination LEFT|RIGHT LEFT|RIGHT arguments of LMOVE and BLMOVE.
+func parseListMove(values []string) (listMove, protocol.Data) {
+	fromRight, ok := parseListEnd(values[2])
+	if !ok {
+		return listMove{}, NewSyntaxError()
+	}
+	toRight, ok := parseListEnd(values[3])
+	if !ok {
+		return listMove{}, NewSyntaxError()
+	}
+
+	return listMove{
+		source:      values[0],
+		destination: values[1],
+		fromRight:   fromRight,
+		toRight:     toRight,
+	}, nil
+}
+
+// parseListEnd returns true for the RIGHT end of a list and false for the LEFT end.
+func parseListEnd(value string) (bool, bool) {
+	switch strings.ToUpper(value) {
+	case "LEFT":
+		return false, true
+	case "RIGHT":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// listMove is a pop from one end of the source list which is pushed to an end of the destination list.
+type listMove struct {
+	source      string
+	destination string
+	fromRight   bool
+	toRight     bool
+}
+
+// move returns the value which was moved, or nil if there is no source list, and true if a value was moved.
+func (m listMove) move(s store.Store) (protocol.Data, bool, error) {
+	value, ok, err := s.ListMove(m.source, m.destination, m.fromRight, m.toRight)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), false, nil
+	}
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return protocol.NewBulkString(value), true, nil
+}
+
+// request returns the LMOVE which replays the move in the command log.
+func (m listMove) request() []byte {
+	return encodeRequest("LMOVE", m.source, m.destination, listEndName(m.fromRight), listEndName(m.toRight))
+}
+
+func listEndName(right bool) string {
+	if right {
+		return "RIGHT"
+	}
+	return "LEFT"
+}
+
+// LMoveCommand pops a value from one end of the source list and pushes it to an end of the destination list, which
+// rotates the list when they are the same key.
+type LMoveCommand struct {
+	requestBytes []byte
+	listMove
+}
+
+func (cmd LMoveCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LMoveCommand) Execute(s store.Store) (protocol.Data, error) {
+	data, _, err := cmd.move(s)
+	return data, err
+}
@@ -0,0 +1,150 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type LMPopValidator struct{}
+
+func (LMPopValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("lmpop")
+	}
+
+	multiPop, errorData := parseListMultiPop(values)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return &LMPopCommand{listMultiPop: multiPop}, nil
+}
+
+// listMultiPop is the keys, end and count of a pop from the first of several keys holding a list.
+type listMultiPop struct {
+	keys      []string
+	fromRight bool
+	count     int
+}
+
+// parseListMultiPop parses the numkeys key [key ...] LEFT|RIGHT [COUNT count] arguments of LMPOP and BLMPOP.
+func parseListMultiPop(values []string) (listMultiPop, protocol.Data) {
+	numberOfKeys, err := strconv.Atoi(values[0])
+	if err != nil || numberOfKeys <= 0 {
+		return listMultiPop{}, protocol.NewSimpleError("ERR numkeys should be greater than 0")
+	}
+	if numberOfKeys > len(values)-2 {
+		return listMultiPop{}, NewSyntaxError()
+	}
+
+	multiPop := listMultiPop{keys: values[1 : numberOfKeys+1], count: 1}
+	fromRight, ok := parseListEnd(values[numberOfKeys+1])
+	if !ok {
+		return listMultiPop{}, NewSyntaxError()
+	}
+	multiPop.fromRight = fromRight
+
+	options := values[numberOfKeys+2:]
+	var countGiven bool
+	for i := 0; i < len(options); i++ {
+		if strings.ToUpper(options[i]) != "COUNT" || i+1 == len(options) || countGiven {
+			return listMultiPop{}, NewSyntaxError()
+		}
+
+		i++
+		count, err := strconv.Atoi(options[i])
+		if err != nil || count <= 0 {
+			return listMultiPop{}, protocol.NewSimpleError("ERR count should be greater than 0")
+		}
+		multiPop.count = count
+		countGiven = true
+	}
+
+	return multiPop, nil
+}
+
+// popFirst pops from the first key holding a list, returning the key which was popped, or nil data if every list
+// is empty.
+func (m listMultiPop) popFirst(s store.Store) (protocol.Data, string, error) {
+	for _, key := range m.keys {
+		length, err := s.ListLength(key)
+
+		if errors.Is(err, store.ErrorWrongOperationType) {
+			return NewWrongOperationTypeError(), "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if length > 0 {
+			data, err := m.pop(s, key)
+			return data, key, err
+		}
+	}
+	return nil, "", nil
+}
+
+// pop removes up to count values from the list at the key, returning the key with an array of the values.
+func (m listMultiPop) pop(s store.Store, key string) (protocol.Data, error) {
+	pop := s.LeftPop
+	if m.fromRight {
+		pop = s.RightPop
+	}
+
+	popped, err := pop(key, m.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil || len(popped) == 0 {
+		return nil, err
+	}
+
+	return protocol.NewArray([]protocol.Data{
+		protocol.NewBulkString(key),
+		newBulkStringsData(popped),
+	}), nil
+}
+
+// request returns the LPOP or RPOP of the key with the count, which replays the pop in the command log.
+func (m listMultiPop) request(key string) []byte {
+	if m.fromRight {
+		return encodeRequest("RPOP", key, strconv.Itoa(m.count))
+	}
+	return encodeRequest("LPOP", key, strconv.Itoa(m.count))
+}
+
+// LMPopCommand pops from the first key holding a list, so it is recorded in the command log as an LPOP or RPOP of
+// the key which was popped.
+type LMPopCommand struct {
+	listMultiPop
+	poppedKey string
+}
+
+func (cmd *LMPopCommand) Request() ([]byte, Type) {
+	if cmd.poppedKey == "" {
+		return nil, TypeUpdate
+	}
+	return cmd.request(cmd.poppedKey), TypeUpdate
+}
+
+func (cmd *LMPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	data, key, err := cmd.popFirst(s)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return protocol.NewNullArray(), nil
+	}
+
+	cmd.poppedKey = key
+	return data, nil
+}
@@ -0,0 +1,63 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandLMPop(t *testing.T) {
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{Data: []protocol.Data{protocol.NewBulkString("LMPOP")}}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(&store.FixedClock{}).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	t.Run("lmpop command normalises to RPOP with the count of the key which was popped", func(t *testing.T) {
+		// Given a list in the second key
+		s := store.New()
+		_, err := s.RightPush("second", []string{"a", "b"})
+		require.NoError(t, err)
+
+		// When we pop from either key
+		cmd := validate(t, "2", "first", "second", "RIGHT", "COUNT", "3")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as popping from the second key
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("RPOP"),
+			protocol.NewBulkString("second"),
+			protocol.NewBulkString("3"),
+		}}, validatedRequest)
+	})
+
+	t.Run("lmpop command of missing keys is not recorded", func(t *testing.T) {
+		s := store.New()
+
+		cmd := validate(t, "1", "key", "LEFT")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
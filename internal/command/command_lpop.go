@@ -0,0 +1,95 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type LPopValidator struct {
+	fromRight bool
+}
+
+func (v LPopValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 || len(values) > 2 {
+		if v.fromRight {
+			return nil, NewWrongNumberOfArgumentsError("rpop")
+		}
+		return nil, NewWrongNumberOfArgumentsError("lpop")
+	}
+
+	cmd := LPopCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		count:        1,
+		fromRight:    v.fromRight,
+	}
+	if len(values) == 2 {
+		count, err := strconv.Atoi(values[1])
+		if err != nil {
+			return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+		}
+		if count < 0 {
+			return nil, protocol.NewSimpleError("ERR value is out of range, must be positive")
+		}
+		cmd.count = count
+		cmd.withCount = true
+	}
+
+	return cmd, nil
+}
+
+// LPopCommand removes values from the start of a list, or from the end for RPOP.
+type LPopCommand struct {
+	requestBytes []byte
+	key          string
+	count        int
+	withCount    bool
+	fromRight    bool
+}
+
+func (cmd LPopCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	pop := s.LeftPop
+	if cmd.fromRight {
+		pop = s.RightPop
+	}
+
+	exists := s.Exists(cmd.key)
+	popped, err := pop(cmd.key, cmd.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.withCount {
+		if len(popped) == 0 {
+			return nil, nil
+		}
+		return protocol.NewBulkString(popped[0]), nil
+	}
+	if !exists {
+		return protocol.NewNullArray(), nil
+	}
+	return newBulkStringsData(popped), nil
+}
+
+func newBulkStringsData(values []string) protocol.Data {
+	data := make([]protocol.Data, len(values))
+	for i, value := range values {
+		data[i] = protocol.NewBulkString(value)
+	}
+	return protocol.NewArray(data)
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type LPosValidator struct{}
+
+func (LPosValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("lpos")
+	}
+
+	cmd := LPosCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		value:        values[1],
+		rank:         1,
+	}
+
+	options := values[2:]
+	for i := 0; i < len(options); i++ {
+		option := strings.ToUpper(options[i])
+		if i+1 == len(options) || (option != "RANK" && option != "COUNT" && option != "MAXLEN") {
+			return nil, NewSyntaxError()
+		}
+
+		i++
+		value, err := strconv.Atoi(options[i])
+		if err != nil {
+			return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+		}
+
+		switch option {
+		case "RANK":
+			if value == 0 {
+				return nil, protocol.NewSimpleError("ERR RANK can't be zero: use 1 to start from the first match, 2 from the second ... or use negative to start from the end of the list")
+			}
+			if value == math.MinInt {
+				return nil, protocol.NewSimpleError("ERR value is out of range")
+			}
+			cmd.rank = value
+		case "COUNT":
+			if value < 0 {
+				return nil, protocol.NewSimpleError("ERR COUNT can't be negative")
+			}
+			cmd.count = value
+			cmd.withCount = true
+		case "MAXLEN":
+			if value < 0 {
+				return nil, protocol.NewSimpleError("ERR MAXLEN can't be negative")
+			}
+			cmd.maxLength = value
+		}
+	}
+
+	return cmd, nil
+}
+
+type LPosCommand struct {
+	requestBytes []byte
+	key          string
+	value        string
+	rank         int
+	count        int
+	withCount    bool
+	maxLength    int
+}
+
+func (cmd LPosCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd LPosCommand) Execute(s store.Store) (protocol.Data, error) {
+	count := cmd.count
+	if !cmd.withCount {
+		count = 1
+	}
+	positions, err := s.ListPositions(cmd.key, cmd.value, cmd.rank, count, cmd.maxLength)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.withCount {
+		if len(positions) == 0 {
+			return nil, nil
+		}
+		return protocol.NewSimpleInteger(int64(positions[0])), nil
+	}
+
+	data := make([]protocol.Data, len(positions))
+	for i, position := range positions {
+		data[i] = protocol.NewSimpleInteger(int64(position))
+	}
+	return protocol.NewArray(data), nil
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type LRemValidator struct{}
+
+func (LRemValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("lrem")
+	}
+
+	count, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return LRemCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		count:        count,
+		value:        values[2],
+	}, nil
+}
+
+type LRemCommand struct {
+	requestBytes []byte
+	key          string
+	count        int
+	value        string
+}
+
+func (cmd LRemCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LRemCommand) Execute(s store.Store) (protocol.Data, error) {
+	removed, err := s.ListRemove(cmd.key, cmd.value, cmd.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(removed), nil
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type LScanValidator struct{}
+
+func (LScanValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("lscan")
+	}
+
+	query, errorData := parseScanQuery(values[1:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return LScanCommand{requestBytes: requestBytes, key: values[0], query: query}, nil
+}
+
+// LScanCommand returns a page of the values of a list from a cursor, which Redis has no command for. The cursor is
+// the index of the next value, so a large list is walked a page at a time without copying the rest of it. Values
+// pushed to the end of the list during a scan are returned by it, while changes before the cursor shift the values
+// after it, as they would for LRANGE.
+type LScanCommand struct {
+	requestBytes []byte
+	key          string
+	query        scanQuery
+}
+
+func (cmd LScanCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd LScanCommand) Execute(s store.Store) (protocol.Data, error) {
+	length, err := s.ListLength(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.query.cursor >= uint64(length) {
+		return newScanData(0, protocol.NewArray(nil)), nil
+	}
+
+	from := int(cmd.query.cursor)
+	to := length
+	if cmd.query.count < length-from {
+		to = from + cmd.query.count
+	}
+	values, err := s.ReadListRange(cmd.key, from, to-1)
+	if err != nil {
+		return nil, err
+	}
+
+	next := uint64(0)
+	if to < length {
+		next = uint64(to)
+	}
+
+	var found []string
+	for _, value := range values.Range() {
+		if cmd.query.matches(value) {
+			found = append(found, value)
+		}
+	}
+	return newScanData(next, newBulkStringsData(found)), nil
+}
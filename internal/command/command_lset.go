@@ -0,0 +1,61 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type LSetValidator struct{}
+
+func (LSetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("lset")
+	}
+
+	index, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return LSetCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		index:        index,
+		value:        values[2],
+	}, nil
+}
+
+type LSetCommand struct {
+	requestBytes []byte
+	key          string
+	index        int
+	value        string
+}
+
+func (cmd LSetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LSetCommand) Execute(s store.Store) (protocol.Data, error) {
+	err := s.ListSet(cmd.key, cmd.index, cmd.value)
+
+	switch {
+	case errors.Is(err, store.ErrorWrongOperationType):
+		return NewWrongOperationTypeError(), nil
+	case errors.Is(err, store.ErrorKeyNotFound):
+		return protocol.NewSimpleError("ERR no such key"), nil
+	case errors.Is(err, store.ErrorIndexOutOfRange):
+		return protocol.NewSimpleError("ERR index out of range"), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return protocol.NewSimpleString("OK"), nil
+}
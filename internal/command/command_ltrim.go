@@ -0,0 +1,61 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type LTrimValidator struct{}
+
+func (LTrimValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("ltrim")
+	}
+
+	start, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	end, err := strconv.Atoi(values[2])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	return LTrimCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		start:        start,
+		end:          end,
+	}, nil
+}
+
+type LTrimCommand struct {
+	requestBytes []byte
+	key          string
+	start        int
+	end          int
+}
+
+func (cmd LTrimCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd LTrimCommand) Execute(s store.Store) (protocol.Data, error) {
+	err := s.ListTrim(cmd.key, cmd.start, cmd.end)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleString("OK"), nil
+}
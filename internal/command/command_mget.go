@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type MGetValidator struct{}
+
+func (MGetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	keys, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(keys) == 0 {
+		return nil, NewWrongNumberOfArgumentsError("mget")
+	}
+
+	return MGetCommand{requestBytes: requestBytes, keys: keys}, nil
+}
+
+// MGetCommand reads the string at each key, where missing keys and keys holding another type are nil.
+type MGetCommand struct {
+	requestBytes []byte
+	keys         []string
+}
+
+func (cmd MGetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd MGetCommand) Execute(s store.Store) (protocol.Data, error) {
+	data := make([]protocol.Data, len(cmd.keys))
+	for i, key := range cmd.keys {
+		value, err := s.ReadString(key)
+		if errors.Is(err, store.ErrorKeyNotFound) || errors.Is(err, store.ErrorWrongOperationType) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		data[i] = protocol.NewBulkString(value)
+	}
+	return protocol.NewArray(data), nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type MoveValidator struct{}
+
+func (MoveValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("move")
+	}
+
+	index, errorData := parseDatabaseIndex(values[1])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return MoveCommand{requestBytes: requestBytes, key: values[0], index: index}, nil
+}
+
+// MoveCommand moves a key with its expiry from the selected database to another, unless the key already exists in
+// the other database.
+type MoveCommand struct {
+	requestBytes []byte
+	key          string
+	index        int
+}
+
+func (cmd MoveCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd MoveCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresDatabases
+}
+
+func (cmd MoveCommand) ExecuteOnDatabases(databases *store.Databases, selected int) (protocol.Data, error) {
+	if !isDatabaseInRange(cmd.index, databases.Count()) {
+		return NewDatabaseOutOfRangeError(), nil
+	}
+	if cmd.index == selected {
+		return newSameObjectsError(), nil
+	}
+
+	if databases.Move(cmd.key, selected, cmd.index) {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
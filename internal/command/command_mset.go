@@ -0,0 +1,61 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type MSetValidator struct {
+	onlyIfAllMissing bool
+}
+
+func (v MSetValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) == 0 || len(values)%2 != 0 {
+		if v.onlyIfAllMissing {
+			return nil, NewWrongNumberOfArgumentsError("msetnx")
+		}
+		return nil, NewWrongNumberOfArgumentsError("mset")
+	}
+
+	return MSetCommand{
+		requestBytes:     requestBytes,
+		keysAndValues:    values,
+		onlyIfAllMissing: v.onlyIfAllMissing,
+	}, nil
+}
+
+// MSetCommand sets the string of each key, replacing any value and expiry. MSETNX sets none of the keys if any of
+// them exist.
+type MSetCommand struct {
+	requestBytes     []byte
+	keysAndValues    []string
+	onlyIfAllMissing bool
+}
+
+func (cmd MSetCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd MSetCommand) Execute(s store.Store) (protocol.Data, error) {
+	if cmd.onlyIfAllMissing {
+		for i := 0; i < len(cmd.keysAndValues); i += 2 {
+			if s.Exists(cmd.keysAndValues[i]) {
+				return protocol.NewSimpleInteger(0), nil
+			}
+		}
+	}
+
+	for i := 0; i < len(cmd.keysAndValues); i += 2 {
+		s.Write(cmd.keysAndValues[i], cmd.keysAndValues[i+1], store.ExpiryOptionNone, 0)
+	}
+
+	if cmd.onlyIfAllMissing {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleString("OK"), nil
+}
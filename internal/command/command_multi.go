@@ -0,0 +1,38 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type MultiValidator struct{}
+
+func (MultiValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	if len(arguments) != 0 {
+		return nil, NewWrongNumberOfArgumentsError("multi")
+	}
+
+	return MultiCommand{requestBytes: requestBytes}, nil
+}
+
+// MultiCommand starts a transaction, queuing the commands that follow on the connection until EXEC or DISCARD.
+type MultiCommand struct {
+	requestBytes []byte
+}
+
+func (cmd MultiCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd MultiCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd MultiCommand) ExecuteInSession(session *Session) protocol.Data {
+	if session.InTransaction() {
+		return protocol.NewSimpleError("ERR MULTI calls can not be nested")
+	}
+
+	session.transaction = &transaction{}
+	return protocol.NewSimpleString("OK")
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type PersistValidator struct{}
+
+func (PersistValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("persist")
+	}
+
+	return PersistCommand{requestBytes: requestBytes, key: values[0]}, nil
+}
+
+type PersistCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd PersistCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd PersistCommand) Execute(s store.Store) (protocol.Data, error) {
+	if s.Persist(cmd.key) {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
@@ -21,15 +21,19 @@ func (PingValidator) Validate(requestBytes []byte, arguments []protocol.Data) (C
 		return PingCommand{
 			requestBytes: requestBytes,
 			response:     arguments[0],
+			message:      arguments[0],
 		}, nil
 	default:
 		return nil, protocol.NewSimpleError("ERR wrong number of arguments for 'ping' command")
 	}
 }
 
+// PingCommand replies with PONG or the message given, which for a connection subscribed using RESP2 is an array
+// like a published message.
 type PingCommand struct {
 	requestBytes []byte
 	response     protocol.Data
+	message      protocol.Data
 }
 
 func (cmd PingCommand) Request() ([]byte, Type) {
@@ -39,3 +43,15 @@ func (cmd PingCommand) Request() ([]byte, Type) {
 func (cmd PingCommand) Execute(_ store.Store) (protocol.Data, error) {
 	return cmd.response, nil
 }
+
+func (cmd PingCommand) ExecuteInSession(session *Session) protocol.Data {
+	if session.ProtocolVersion != protocol.Version2 || !session.IsSubscribed() {
+		return cmd.response
+	}
+
+	message := cmd.message
+	if message == nil {
+		message = protocol.NewBulkString("")
+	}
+	return protocol.NewArray([]protocol.Data{protocol.NewBulkString("pong"), message})
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type PSubscribeValidator struct{}
+
+func (PSubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("psubscribe")
+	}
+
+	return PSubscribeCommand{requestBytes: requestBytes, patterns: values}, nil
+}
+
+// PSubscribeCommand subscribes the connection to the channels matching glob-style patterns, after which the messages
+// published to them are pushed to it.
+type PSubscribeCommand struct {
+	requestBytes []byte
+	patterns     []string
+}
+
+func (cmd PSubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd PSubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd PSubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	replies := make([]protocol.Data, len(cmd.patterns))
+	for i, pattern := range cmd.patterns {
+		count := session.registry.PSubscribe(session.Subscriber, pattern)
+		replies[i] = newSubscriptionData("psubscribe", protocol.NewBulkString(pattern), count)
+	}
+	return protocol.NewReplies(replies)
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type PublishValidator struct{}
+
+func (PublishValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("publish")
+	}
+
+	return PublishCommand{requestBytes: requestBytes, channel: values[0], message: values[1]}, nil
+}
+
+// PublishCommand sends a message to the subscribers of a channel and of the patterns matching it, returning how many
+// received it.
+type PublishCommand struct {
+	requestBytes []byte
+	channel      string
+	message      string
+}
+
+// Request is not logged, as messages are only sent to the subscribers connected when they are published.
+func (cmd PublishCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd PublishCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd PublishCommand) ExecuteInSession(session *Session) protocol.Data {
+	return protocol.NewSimpleInteger(int64(session.registry.Publish(cmd.channel, cmd.message)))
+}
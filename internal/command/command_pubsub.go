@@ -0,0 +1,100 @@
+package command
+
+import (
+	"fmt"
+	"redis-challenge/internal/glob"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type PubSubValidator struct{}
+
+func (PubSubValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("pubsub")
+	}
+
+	subcommand := strings.ToUpper(values[0])
+	switch {
+	case subcommand == "CHANNELS" && len(values) > 2:
+		return nil, NewWrongNumberOfArgumentsError("pubsub|channels")
+	case subcommand == "SHARDCHANNELS" && len(values) > 2:
+		return nil, NewWrongNumberOfArgumentsError("pubsub|shardchannels")
+	case subcommand == "NUMPAT" && len(values) != 1:
+		return nil, NewWrongNumberOfArgumentsError("pubsub|numpat")
+	case !isPubSubSubcommand(subcommand):
+		return nil, protocol.NewSimpleError(fmt.Sprintf("ERR unknown subcommand '%s'. Try PUBSUB HELP.", values[0]))
+	}
+
+	return PubSubCommand{requestBytes: requestBytes, subcommand: subcommand, arguments: values[1:]}, nil
+}
+
+func isPubSubSubcommand(subcommand string) bool {
+	switch subcommand {
+	case "CHANNELS", "NUMSUB", "NUMPAT", "SHARDCHANNELS", "SHARDNUMSUB":
+		return true
+	default:
+		return false
+	}
+}
+
+// PubSubCommand reports on the subscriptions of every connection: the channels or shard channels subscribed to,
+// optionally those matching a pattern, the number of subscribers of channels or shard channels, or the number of
+// patterns subscribed to.
+type PubSubCommand struct {
+	requestBytes []byte
+	subcommand   string
+	arguments    []string
+}
+
+func (cmd PubSubCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd PubSubCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd PubSubCommand) ExecuteInSession(session *Session) protocol.Data {
+	switch cmd.subcommand {
+	case "CHANNELS":
+		return cmd.matching(session.registry.Channels())
+	case "SHARDCHANNELS":
+		return cmd.matching(session.registry.ShardChannels())
+	case "NUMSUB":
+		return cmd.subscriberCounts(session.registry.SubscriberCount)
+	case "SHARDNUMSUB":
+		return cmd.subscriberCounts(session.registry.ShardSubscriberCount)
+	default:
+		return protocol.NewSimpleInteger(int64(session.registry.PatternCount()))
+	}
+}
+
+// matching returns the channels matching the pattern given, or every channel without a pattern.
+func (cmd PubSubCommand) matching(channels []string) protocol.Data {
+	if len(cmd.arguments) == 1 {
+		matching := channels[:0]
+		for _, channel := range channels {
+			if glob.Match(cmd.arguments[0], channel) {
+				matching = append(matching, channel)
+			}
+		}
+		channels = matching
+	}
+	return newBulkStringsData(channels)
+}
+
+// subscriberCounts returns each channel given followed by its number of subscribers.
+func (cmd PubSubCommand) subscriberCounts(subscriberCount func(string) int) protocol.Data {
+	counts := make([]protocol.Data, 0, 2*len(cmd.arguments))
+	for _, channel := range cmd.arguments {
+		counts = append(counts, protocol.NewBulkString(channel), protocol.NewSimpleInteger(int64(subscriberCount(channel))))
+	}
+	return protocol.NewArray(counts)
+}
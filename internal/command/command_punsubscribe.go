@@ -0,0 +1,36 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type PUnsubscribeValidator struct{}
+
+func (PUnsubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return PUnsubscribeCommand{requestBytes: requestBytes, patterns: values}, nil
+}
+
+// PUnsubscribeCommand unsubscribes the connection from patterns, or from every pattern if none are given.
+type PUnsubscribeCommand struct {
+	requestBytes []byte
+	patterns     []string
+}
+
+func (cmd PUnsubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd PUnsubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd PUnsubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	subscriber := session.Subscriber
+	return unsubscribe(subscriber, "punsubscribe", cmd.patterns, subscriber.Patterns(), subscriber.Count(), session.registry.PUnsubscribe)
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type RandomKeyValidator struct{}
+
+func (RandomKeyValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	if len(arguments) != 0 {
+		return nil, NewWrongNumberOfArgumentsError("randomkey")
+	}
+
+	return RandomKeyCommand{requestBytes: requestBytes}, nil
+}
+
+type RandomKeyCommand struct {
+	requestBytes []byte
+}
+
+func (cmd RandomKeyCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd RandomKeyCommand) Execute(s store.Store) (protocol.Data, error) {
+	if key, ok := s.RandomKey(); ok {
+		return protocol.NewBulkString(key), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type RenameValidator struct {
+	onlyIfMissing bool
+}
+
+func (v RenameValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		if v.onlyIfMissing {
+			return nil, NewWrongNumberOfArgumentsError("renamenx")
+		}
+		return nil, NewWrongNumberOfArgumentsError("rename")
+	}
+
+	return RenameCommand{
+		requestBytes:  requestBytes,
+		source:        values[0],
+		destination:   values[1],
+		onlyIfMissing: v.onlyIfMissing,
+	}, nil
+}
+
+// RenameCommand moves the value and expiry of a key to another key. RENAMENX does not replace an existing key.
+type RenameCommand struct {
+	requestBytes  []byte
+	source        string
+	destination   string
+	onlyIfMissing bool
+}
+
+func (cmd RenameCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd RenameCommand) Execute(s store.Store) (protocol.Data, error) {
+	renamed, err := s.Rename(cmd.source, cmd.destination, cmd.onlyIfMissing)
+	if errors.Is(err, store.ErrorKeyNotFound) {
+		return protocol.NewSimpleError("ERR no such key"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !cmd.onlyIfMissing:
+		return protocol.NewSimpleString("OK"), nil
+	case renamed:
+		return protocol.NewSimpleInteger(1), nil
+	default:
+		return protocol.NewSimpleInteger(0), nil
+	}
+}
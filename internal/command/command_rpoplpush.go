@@ -0,0 +1,27 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+)
+
+type RPopLPushValidator struct{}
+
+func (RPopLPushValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("rpoplpush")
+	}
+
+	return LMoveCommand{
+		requestBytes: requestBytes,
+		listMove: listMove{
+			source:      values[0],
+			destination: values[1],
+			fromRight:   true,
+		},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SAddValidator struct{}
+
+func (SAddValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("sadd")
+	}
+
+	return SAddCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		members:      values[1:],
+	}, nil
+}
+
+type SAddCommand struct {
+	requestBytes []byte
+	key          string
+	members      []string
+}
+
+func (cmd SAddCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SAddCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.SetAdd(cmd.key, cmd.members)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(count), nil
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"fmt"
+	"redis-challenge/internal/glob"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+const defaultScanCount = 10
+
+// scanQuery is a cursor and the options of a scan, where the count is how many keys or members to look at rather
+// than how many to return, as those not matching the pattern or type are left out.
+type scanQuery struct {
+	cursor   uint64
+	pattern  string
+	count    int
+	typeName string
+}
+
+// parseScanQuery parses a cursor followed by the MATCH and COUNT options, as well as TYPE if it is allowed.
+func parseScanQuery(arguments []string, allowType bool) (scanQuery, protocol.Data) {
+	cursor, err := strconv.ParseUint(arguments[0], 10, 64)
+	if err != nil {
+		return scanQuery{}, protocol.NewSimpleError("ERR invalid cursor")
+	}
+
+	query := scanQuery{cursor: cursor, pattern: "*", count: defaultScanCount}
+	for i := 1; i < len(arguments); i += 2 {
+		if i+1 >= len(arguments) {
+			return scanQuery{}, NewSyntaxError()
+		}
+		option, value := strings.ToUpper(arguments[i]), arguments[i+1]
+
+		switch {
+		case option == "MATCH":
+			query.pattern = value
+		case option == "COUNT":
+			count, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return scanQuery{}, protocol.NewSimpleError("ERR value is not an integer or out of range")
+			}
+			if count < 1 {
+				return scanQuery{}, NewSyntaxError()
+			}
+			query.count = int(count)
+		case option == "TYPE" && allowType:
+			query.typeName = strings.ToLower(value)
+			if !isTypeName(query.typeName) {
+				return scanQuery{}, protocol.NewSimpleError(fmt.Sprintf("ERR unknown type name '%s'", value))
+			}
+		default:
+			return scanQuery{}, NewSyntaxError()
+		}
+	}
+	return query, nil
+}
+
+// isTypeName returns true if the name is one that TYPE can report, including streams which are never stored.
+func isTypeName(name string) bool {
+	switch name {
+	case "string", "list", "hash", "set", "zset", "stream":
+		return true
+	default:
+		return false
+	}
+}
+
+// matches returns true if the key or member matches the pattern of the scan.
+func (q scanQuery) matches(member string) bool {
+	return q.pattern == "*" || glob.Match(q.pattern, member)
+}
+
+// newScanData returns the cursor to continue from with the keys or members found.
+func newScanData(next uint64, found protocol.Data) protocol.Data {
+	return protocol.NewArray([]protocol.Data{protocol.NewBulkString(strconv.FormatUint(next, 10)), found})
+}
+
+type ScanValidator struct{}
+
+func (ScanValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("scan")
+	}
+
+	query, errorData := parseScanQuery(values, true)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ScanCommand{requestBytes: requestBytes, query: query}, nil
+}
+
+// ScanCommand returns a page of the keys from a cursor, so a client can walk the keyspace without holding up the
+// server as KEYS does.
+type ScanCommand struct {
+	requestBytes []byte
+	query        scanQuery
+}
+
+func (cmd ScanCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ScanCommand) Execute(s store.Store) (protocol.Data, error) {
+	next, keys := s.Scan(cmd.query.cursor, cmd.query.count)
+
+	found := keys[:0]
+	for _, key := range keys {
+		if cmd.query.matches(key) && (cmd.query.typeName == "" || s.Type(key) == cmd.query.typeName) {
+			found = append(found, key)
+		}
+	}
+	return newScanData(next, newBulkStringsData(found)), nil
+}
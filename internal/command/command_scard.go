@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SCardValidator struct{}
+
+func (SCardValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("scard")
+	}
+
+	return SCardCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type SCardCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd SCardCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SCardCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SDiffValidator struct{}
+
+func (SDiffValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("sdiff")
+	}
+
+	return SDiffCommand{
+		requestBytes: requestBytes,
+		keys:         values,
+	}, nil
+}
+
+type SDiffCommand struct {
+	requestBytes []byte
+	keys         []string
+}
+
+func (cmd SDiffCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SDiffCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetDifference(cmd.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newSetData(members), nil
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SDiffStoreValidator struct{}
+
+func (SDiffStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("sdiffstore")
+	}
+
+	return SDiffStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		keys:         values[1:],
+	}, nil
+}
+
+type SDiffStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	keys         []string
+}
+
+func (cmd SDiffStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SDiffStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetDifference(cmd.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.WriteSet(cmd.destination, members, "sdiffstore")
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SelectValidator struct{}
+
+func (SelectValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("select")
+	}
+
+	index, errorData := parseDatabaseIndex(values[0])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return SelectCommand{requestBytes: requestBytes, index: index}, nil
+}
+
+// SelectCommand selects the database that later commands on the connection act on.
+type SelectCommand struct {
+	requestBytes []byte
+	index        int
+}
+
+// Request is not logged, as the command log selects the database of each request it records.
+func (cmd SelectCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SelectCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd SelectCommand) ExecuteInSession(session *Session) protocol.Data {
+	if !isDatabaseInRange(cmd.index, session.databaseCount) {
+		return NewDatabaseOutOfRangeError()
+	}
+
+	session.Database = cmd.index
+	return protocol.NewSimpleString("OK")
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"bytes"
+	"errors"
 	"log/slog"
 	"redis-challenge/internal/protocol"
 	"redis-challenge/internal/store"
@@ -164,6 +165,9 @@ func (cmd SetCommand) Execute(s store.Store) (protocol.Data, error) {
 	var oldValue protocol.Data
 	if exists && cmd.get {
 		oldText, err := s.ReadString(cmd.key)
+		if errors.Is(err, store.ErrorWrongOperationType) {
+			return NewWrongOperationTypeError(), nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"fmt"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+// SetExValidator validates SETEX, or PSETEX when the expiry is in milliseconds, which are logged as a SET with the
+// absolute expiry.
+type SetExValidator struct {
+	clock          store.Clock
+	inMilliseconds bool
+}
+
+func (v SetExValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	name, option := "setex", store.ExpiryOptionExpirySeconds
+	if v.inMilliseconds {
+		name, option = "psetex", store.ExpiryOptionExpiryMilliseconds
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError(name)
+	}
+
+	expiry, errorData := parsePositiveExpiry(v.clock, name, option, values[1])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return &SetExCommand{
+		clock:  v.clock,
+		name:   name,
+		key:    values[0],
+		value:  values[2],
+		expiry: expiry,
+	}, nil
+}
+
+// SetExCommand sets the string at the key to expire in the time after it is executed, being logged as a SET with
+// the absolute time the key expires.
+type SetExCommand struct {
+	clock     store.Clock
+	name      string
+	key       string
+	value     string
+	expiry    int64
+	timestamp int64
+	updated   bool
+}
+
+func (cmd *SetExCommand) Request() ([]byte, Type) {
+	if !cmd.updated {
+		return nil, TypeUpdate
+	}
+	return encodeRequest("SET", cmd.key, cmd.value, "PXAT", strconv.FormatInt(cmd.timestamp, 10)), TypeUpdate
+}
+
+func (cmd *SetExCommand) Execute(s store.Store) (protocol.Data, error) {
+	timestamp, ok := expiryFromNow(cmd.clock, cmd.expiry)
+	if !ok {
+		return protocol.NewSimpleError(fmt.Sprintf("ERR invalid expire time in '%s' command", cmd.name)), nil
+	}
+
+	s.Write(cmd.key, cmd.value, store.ExpiryOptionExpiryUnixTimeInMilliseconds, timestamp)
+	cmd.timestamp = timestamp
+	cmd.updated = true
+	return protocol.NewSimpleString("OK"), nil
+}
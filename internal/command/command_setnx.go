@@ -0,0 +1,45 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SetNxValidator struct{}
+
+func (SetNxValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("setnx")
+	}
+
+	return SetNxCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		value:        values[1],
+	}, nil
+}
+
+// SetNxCommand sets the string only if the key does not exist, returning 1 if it was set.
+type SetNxCommand struct {
+	requestBytes []byte
+	key          string
+	value        string
+}
+
+func (cmd SetNxCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SetNxCommand) Execute(s store.Store) (protocol.Data, error) {
+	if s.Exists(cmd.key) {
+		return protocol.NewSimpleInteger(0), nil
+	}
+
+	s.Write(cmd.key, cmd.value, store.ExpiryOptionNone, 0)
+	return protocol.NewSimpleInteger(1), nil
+}
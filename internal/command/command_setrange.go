@@ -0,0 +1,75 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type SetRangeValidator struct{}
+
+func (SetRangeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("setrange")
+	}
+
+	offset, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	if offset < 0 {
+		return nil, protocol.NewSimpleError("ERR offset is out of range")
+	}
+
+	return SetRangeCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		offset:       offset,
+		value:        values[2],
+	}, nil
+}
+
+// SetRangeCommand overwrites the string from the offset with the value, padding the string with zero bytes when
+// the offset is beyond its end and keeping any expiry of the key.
+type SetRangeCommand struct {
+	requestBytes []byte
+	key          string
+	offset       int64
+	value        string
+}
+
+func (cmd SetRangeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SetRangeCommand) Execute(s store.Store) (protocol.Data, error) {
+	current, err := s.ReadString(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil && !errors.Is(err, store.ErrorKeyNotFound) {
+		return nil, err
+	}
+
+	if len(cmd.value) == 0 {
+		return protocol.NewSimpleInteger(int64(len(current))), nil
+	}
+	if cmd.offset+int64(len(cmd.value)) > maximumStringLength {
+		return newStringTooLongError(), nil
+	}
+
+	length := max(int(cmd.offset)+len(cmd.value), len(current))
+	updated := make([]byte, length)
+	copy(updated, current)
+	copy(updated[cmd.offset:], cmd.value)
+
+	s.UpdateString(cmd.key, string(updated), "setrange")
+	return protocol.NewSimpleInteger(int64(length)), nil
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SInterValidator struct{}
+
+func (SInterValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("sinter")
+	}
+
+	return SInterCommand{
+		requestBytes: requestBytes,
+		keys:         values,
+	}, nil
+}
+
+type SInterCommand struct {
+	requestBytes []byte
+	keys         []string
+}
+
+func (cmd SInterCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SInterCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetIntersection(cmd.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newSetData(members), nil
+}
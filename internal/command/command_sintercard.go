@@ -0,0 +1,74 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type SInterCardValidator struct{}
+
+func (SInterCardValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("sintercard")
+	}
+
+	numberOfKeys, err := strconv.Atoi(values[0])
+	if err != nil || numberOfKeys <= 0 {
+		return nil, protocol.NewSimpleError("ERR numkeys should be greater than 0")
+	}
+	if numberOfKeys > len(values)-1 {
+		return nil, protocol.NewSimpleError("ERR Number of keys can't be greater than number of args")
+	}
+
+	cmd := SInterCardCommand{
+		requestBytes: requestBytes,
+		keys:         values[1 : numberOfKeys+1],
+	}
+
+	options := values[numberOfKeys+1:]
+	for i := 0; i < len(options); i++ {
+		if strings.ToUpper(options[i]) != "LIMIT" || i+1 == len(options) {
+			return nil, NewSyntaxError()
+		}
+
+		i++
+		limit, err := strconv.Atoi(options[i])
+		if err != nil || limit < 0 {
+			return nil, protocol.NewSimpleError("ERR LIMIT can't be negative")
+		}
+		cmd.limit = limit
+	}
+
+	return cmd, nil
+}
+
+type SInterCardCommand struct {
+	requestBytes []byte
+	keys         []string
+	limit        int
+}
+
+func (cmd SInterCardCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SInterCardCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.SetIntersectionCardinality(cmd.keys, cmd.limit)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(count)), nil
+}
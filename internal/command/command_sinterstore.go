@@ -0,0 +1,50 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SInterStoreValidator struct{}
+
+func (SInterStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("sinterstore")
+	}
+
+	return SInterStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		keys:         values[1:],
+	}, nil
+}
+
+type SInterStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	keys         []string
+}
+
+func (cmd SInterStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SInterStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetIntersection(cmd.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.WriteSet(cmd.destination, members, "sinterstore")
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SIsMemberValidator struct{}
+
+func (SIsMemberValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("sismember")
+	}
+
+	return SIsMemberCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		member:       values[1],
+	}, nil
+}
+
+type SIsMemberCommand struct {
+	requestBytes []byte
+	key          string
+	member       string
+}
+
+func (cmd SIsMemberCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SIsMemberCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if members.Contains(cmd.member) {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
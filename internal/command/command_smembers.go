@@ -0,0 +1,56 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/set"
+	"redis-challenge/internal/store"
+)
+
+type SMembersValidator struct{}
+
+func (SMembersValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("smembers")
+	}
+
+	return SMembersCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type SMembersCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd SMembersCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SMembersCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newSetData(members), nil
+}
+
+func newSetData(members *set.Set) protocol.Data {
+	data := make([]protocol.Data, 0, members.Len())
+	for member := range members.Members() {
+		data = append(data, protocol.NewBulkString(member))
+	}
+	return protocol.NewSet(data)
+}
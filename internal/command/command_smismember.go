@@ -0,0 +1,57 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SMIsMemberValidator struct{}
+
+func (SMIsMemberValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("smismember")
+	}
+
+	return SMIsMemberCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		members:      values[1:],
+	}, nil
+}
+
+type SMIsMemberCommand struct {
+	requestBytes []byte
+	key          string
+	members      []string
+}
+
+func (cmd SMIsMemberCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SMIsMemberCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]protocol.Data, len(cmd.members))
+	for i, member := range cmd.members {
+		if members.Contains(member) {
+			data[i] = protocol.NewSimpleInteger(1)
+		} else {
+			data[i] = protocol.NewSimpleInteger(0)
+		}
+	}
+	return protocol.NewArray(data), nil
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SMoveValidator struct{}
+
+func (SMoveValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("smove")
+	}
+
+	return SMoveCommand{
+		requestBytes: requestBytes,
+		source:       values[0],
+		destination:  values[1],
+		member:       values[2],
+	}, nil
+}
+
+type SMoveCommand struct {
+	requestBytes []byte
+	source       string
+	destination  string
+	member       string
+}
+
+func (cmd SMoveCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SMoveCommand) Execute(s store.Store) (protocol.Data, error) {
+	moved, err := s.SetMove(cmd.source, cmd.destination, cmd.member)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if moved {
+		return protocol.NewSimpleInteger(1), nil
+	}
+	return protocol.NewSimpleInteger(0), nil
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type SPopValidator struct{}
+
+func (SPopValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("spop")
+	}
+	if len(values) > 2 {
+		return nil, NewSyntaxError()
+	}
+
+	cmd := &SPopCommand{key: values[0], count: 1}
+	if len(values) == 2 {
+		count, err := strconv.Atoi(values[1])
+		if err != nil {
+			return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+		}
+		if count < 0 {
+			return nil, protocol.NewSimpleError("ERR value is out of range, must be positive")
+		}
+		cmd.count = count
+		cmd.withCount = true
+	}
+
+	return cmd, nil
+}
+
+// SPopCommand removes members picked at random, so it is recorded in the command log as an SREM of the
+// members which were popped.
+type SPopCommand struct {
+	key       string
+	count     int
+	withCount bool
+	popped    []string
+}
+
+func (cmd *SPopCommand) Request() ([]byte, Type) {
+	if len(cmd.popped) == 0 {
+		return nil, TypeUpdate
+	}
+	return encodeRequest(append([]string{"SREM", cmd.key}, cmd.popped...)...), TypeUpdate
+}
+
+func (cmd *SPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	popped, err := s.SetPop(cmd.key, cmd.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cmd.popped = popped
+
+	if !cmd.withCount {
+		if len(popped) == 0 {
+			return nil, nil
+		}
+		return protocol.NewBulkString(popped[0]), nil
+	}
+
+	data := make([]protocol.Data, len(popped))
+	for i, member := range popped {
+		data[i] = protocol.NewBulkString(member)
+	}
+	return protocol.NewSet(data), nil
+}
@@ -0,0 +1,63 @@
+package command_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestNormalisingCommandSPop(t *testing.T) {
+
+	validate := func(t *testing.T, arguments ...string) command.Command {
+		request := protocol.Array{Data: []protocol.Data{protocol.NewBulkString("SPOP")}}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		err := protocol.WriteData(buffer, request)
+		require.NoError(t, err)
+
+		cmd, errorData := command.NewValidator(&store.FixedClock{}).Validate(buffer.Bytes(), request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	t.Run("spop command normalises to SREM of the popped members", func(t *testing.T) {
+		// Given a set with a single member
+		s := store.New()
+		_, err := s.SetAdd("key", []string{"m1"})
+		require.NoError(t, err)
+
+		// When we pop a member
+		cmd := validate(t, "key", "2")
+		_, err = cmd.Execute(s)
+		require.NoError(t, err)
+
+		// Then the command is recorded as removing the popped member
+		requestBytes, requestType := cmd.Request()
+		assert.Equal(t, command.TypeUpdate, requestType)
+
+		validatedRequest, _ := protocol.ReadFrame(requestBytes)
+		assert.Equal(t, protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("SREM"),
+			protocol.NewBulkString("key"),
+			protocol.NewBulkString("m1"),
+		}}, validatedRequest)
+	})
+
+	t.Run("spop command of a missing key is not recorded", func(t *testing.T) {
+		s := store.New()
+
+		cmd := validate(t, "key")
+		_, err := cmd.Execute(s)
+		require.NoError(t, err)
+
+		requestBytes, _ := cmd.Request()
+		assert.Empty(t, requestBytes)
+	})
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SPublishValidator struct{}
+
+func (SPublishValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("spublish")
+	}
+
+	return SPublishCommand{requestBytes: requestBytes, shardChannel: values[0], message: values[1]}, nil
+}
+
+// SPublishCommand sends a message to the subscribers of a shard channel, returning how many received it.
+type SPublishCommand struct {
+	requestBytes []byte
+	shardChannel string
+	message      string
+}
+
+// Request is not logged, as messages are only sent to the subscribers connected when they are published.
+func (cmd SPublishCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SPublishCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd SPublishCommand) ExecuteInSession(session *Session) protocol.Data {
+	return protocol.NewSimpleInteger(int64(session.registry.SPublish(cmd.shardChannel, cmd.message)))
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SRandMemberValidator struct{}
+
+func (SRandMemberValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("srandmember")
+	}
+	if len(values) > 2 {
+		return nil, NewSyntaxError()
+	}
+
+	cmd := SRandMemberCommand{requestBytes: requestBytes, key: values[0], count: 1}
+	if len(values) == 2 {
+		count, errorData := parseRandomCount(values[1])
+		if errorData != nil {
+			return nil, errorData
+		}
+		cmd.count = count
+		cmd.withCount = true
+	}
+
+	return cmd, nil
+}
+
+type SRandMemberCommand struct {
+	requestBytes []byte
+	key          string
+	count        int
+	withCount    bool
+}
+
+func (cmd SRandMemberCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SRandMemberCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetRandomMembers(cmd.key, cmd.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.withCount {
+		if len(members) == 0 {
+			return nil, nil
+		}
+		return protocol.NewBulkString(members[0]), nil
+	}
+
+	data := make([]protocol.Data, len(members))
+	for i, member := range members {
+		data[i] = protocol.NewBulkString(member)
+	}
+	return protocol.NewArray(data), nil
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SRemValidator struct{}
+
+func (SRemValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("srem")
+	}
+
+	return SRemCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		members:      values[1:],
+	}, nil
+}
+
+type SRemCommand struct {
+	requestBytes []byte
+	key          string
+	members      []string
+}
+
+func (cmd SRemCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SRemCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.SetRemove(cmd.key, cmd.members)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(count), nil
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SScanValidator struct{}
+
+func (SScanValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("sscan")
+	}
+
+	query, errorData := parseScanQuery(values[1:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return SScanCommand{requestBytes: requestBytes, key: values[0], query: query}, nil
+}
+
+// SScanCommand returns a page of the members of a set from a cursor.
+type SScanCommand struct {
+	requestBytes []byte
+	key          string
+	query        scanQuery
+}
+
+func (cmd SScanCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SScanCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	next, page := members.Scan(cmd.query.cursor, cmd.query.count)
+
+	found := page[:0]
+	for _, member := range page {
+		if cmd.query.matches(member) {
+			found = append(found, member)
+		}
+	}
+	return newScanData(next, newBulkStringsData(found)), nil
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
+	"redis-challenge/internal/store"
+)
+
+type SSubscribeValidator struct{}
+
+func (SSubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("ssubscribe")
+	}
+
+	if !isInOneSlot(values) {
+		return nil, NewCrossSlotError()
+	}
+
+	return SSubscribeCommand{requestBytes: requestBytes, shardChannels: values}, nil
+}
+
+// isInOneSlot returns true if every shard channel is assigned the same hash slot, so a cluster would have a single
+// shard to send the request to.
+func isInOneSlot(shardChannels []string) bool {
+	for _, shardChannel := range shardChannels[1:] {
+		if pubsub.Slot(shardChannel) != pubsub.Slot(shardChannels[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SSubscribeCommand subscribes the connection to shard channels, which are apart from other channels and only
+// receive the messages published to them with SPUBLISH.
+type SSubscribeCommand struct {
+	requestBytes  []byte
+	shardChannels []string
+}
+
+func (cmd SSubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SSubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd SSubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	replies := make([]protocol.Data, len(cmd.shardChannels))
+	for i, shardChannel := range cmd.shardChannels {
+		count := session.registry.SSubscribe(session.Subscriber, shardChannel)
+		replies[i] = newSubscriptionData("ssubscribe", protocol.NewBulkString(shardChannel), count)
+	}
+	return protocol.NewReplies(replies)
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type StrLenValidator struct{}
+
+func (StrLenValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("strlen")
+	}
+
+	return StrLenCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type StrLenCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd StrLenCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd StrLenCommand) Execute(s store.Store) (protocol.Data, error) {
+	value, err := s.ReadString(cmd.key)
+
+	if errors.Is(err, store.ErrorKeyNotFound) {
+		return protocol.NewSimpleInteger(0), nil
+	}
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(len(value))), nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SubscribeValidator struct{}
+
+func (SubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("subscribe")
+	}
+
+	return SubscribeCommand{requestBytes: requestBytes, channels: values}, nil
+}
+
+// SubscribeCommand subscribes the connection to channels, after which the messages published to them are pushed to
+// it.
+type SubscribeCommand struct {
+	requestBytes []byte
+	channels     []string
+}
+
+func (cmd SubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd SubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	replies := make([]protocol.Data, len(cmd.channels))
+	for i, channel := range cmd.channels {
+		count := session.registry.Subscribe(session.Subscriber, channel)
+		replies[i] = newSubscriptionData("subscribe", protocol.NewBulkString(channel), count)
+	}
+	return protocol.NewReplies(replies)
+}
+
+// newSubscriptionData returns the reply confirming a change to the subscriptions of a connection, which is pushed
+// for RESP3 as it may come between messages, with the count of subscriptions the connection then has.
+func newSubscriptionData(kind string, name protocol.Data, count int) protocol.Data {
+	return protocol.NewPush([]protocol.Data{protocol.NewBulkString(kind), name, protocol.NewSimpleInteger(int64(count))})
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SUnionValidator struct{}
+
+func (SUnionValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("sunion")
+	}
+
+	return SUnionCommand{
+		requestBytes: requestBytes,
+		keys:         values,
+	}, nil
+}
+
+type SUnionCommand struct {
+	requestBytes []byte
+	keys         []string
+}
+
+func (cmd SUnionCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SUnionCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SetUnion(cmd.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newSetData(members), nil
+}
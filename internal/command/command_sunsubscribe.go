@@ -0,0 +1,41 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type SUnsubscribeValidator struct{}
+
+func (SUnsubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) > 0 && !isInOneSlot(values) {
+		return nil, NewCrossSlotError()
+	}
+
+	return SUnsubscribeCommand{requestBytes: requestBytes, shardChannels: values}, nil
+}
+
+// SUnsubscribeCommand unsubscribes the connection from shard channels, or from every shard channel if none are
+// given.
+type SUnsubscribeCommand struct {
+	requestBytes  []byte
+	shardChannels []string
+}
+
+func (cmd SUnsubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd SUnsubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd SUnsubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	subscriber := session.Subscriber
+	return unsubscribe(subscriber, "sunsubscribe", cmd.shardChannels, subscriber.ShardChannels(), subscriber.ShardCount(), session.registry.SUnsubscribe)
+}
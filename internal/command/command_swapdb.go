@@ -0,0 +1,56 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type SwapDBValidator struct{}
+
+func (SwapDBValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("swapdb")
+	}
+
+	first, err := strconv.Atoi(values[0])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR invalid first DB index")
+	}
+	second, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, protocol.NewSimpleError("ERR invalid second DB index")
+	}
+
+	return SwapDBCommand{requestBytes: requestBytes, first: first, second: second}, nil
+}
+
+// SwapDBCommand swaps the keys of two databases, so clients with either database selected see the keys of the
+// other.
+type SwapDBCommand struct {
+	requestBytes []byte
+	first        int
+	second       int
+}
+
+func (cmd SwapDBCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd SwapDBCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresDatabases
+}
+
+func (cmd SwapDBCommand) ExecuteOnDatabases(databases *store.Databases, _ int) (protocol.Data, error) {
+	if !isDatabaseInRange(cmd.first, databases.Count()) || !isDatabaseInRange(cmd.second, databases.Count()) {
+		return NewDatabaseOutOfRangeError(), nil
+	}
+
+	databases.Swap(cmd.first, cmd.second)
+	return protocol.NewSimpleString("OK"), nil
+}
@@ -0,0 +1,21 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+)
+
+// TouchValidator validates TOUCH, which counts the keys that exist in the same way as EXISTS.
+type TouchValidator struct{}
+
+func (TouchValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	keys, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(keys) == 0 {
+		return nil, NewWrongNumberOfArgumentsError("touch")
+	}
+
+	return ExistsCommand{requestBytes: requestBytes, keys: keys}, nil
+}
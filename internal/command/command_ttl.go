@@ -0,0 +1,69 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+// TTLValidator validates TTL and PTTL, which return the time left before a key expires, and EXPIRETIME and
+// PEXPIRETIME, which return the time when it expires.
+type TTLValidator struct {
+	clock          store.Clock
+	name           string
+	inMilliseconds bool
+	absolute       bool
+}
+
+func (v TTLValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError(v.name)
+	}
+
+	return TTLCommand{
+		requestBytes:   requestBytes,
+		clock:          v.clock,
+		key:            values[0],
+		inMilliseconds: v.inMilliseconds,
+		absolute:       v.absolute,
+	}, nil
+}
+
+// TTLCommand returns -2 if the key does not exist and -1 if it has no expiry.
+type TTLCommand struct {
+	requestBytes   []byte
+	clock          store.Clock
+	key            string
+	inMilliseconds bool
+	absolute       bool
+}
+
+func (cmd TTLCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd TTLCommand) Execute(s store.Store) (protocol.Data, error) {
+	expiry, hasExpiry, err := s.Expiry(cmd.key)
+	if errors.Is(err, store.ErrorKeyNotFound) {
+		return protocol.NewSimpleInteger(-2), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !hasExpiry {
+		return protocol.NewSimpleInteger(-1), nil
+	}
+
+	if !cmd.absolute {
+		expiry = max(expiry-cmd.clock.Now(), 0)
+	}
+	if !cmd.inMilliseconds {
+		expiry = (expiry + 500) / 1000
+	}
+	return protocol.NewSimpleInteger(expiry), nil
+}
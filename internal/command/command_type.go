@@ -0,0 +1,34 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type TypeValidator struct{}
+
+func (TypeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("type")
+	}
+
+	return TypeCommand{requestBytes: requestBytes, key: values[0]}, nil
+}
+
+type TypeCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd TypeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd TypeCommand) Execute(s store.Store) (protocol.Data, error) {
+	return protocol.NewSimpleString(s.Type(cmd.key)), nil
+}
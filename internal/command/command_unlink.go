@@ -0,0 +1,21 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+)
+
+// UnlinkValidator validates UNLINK, which deletes keys in the same way as DEL.
+type UnlinkValidator struct{}
+
+func (UnlinkValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	keys, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(keys) == 0 {
+		return nil, NewWrongNumberOfArgumentsError("unlink")
+	}
+
+	return DelCommand{requestBytes: requestBytes, keys: keys}, nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
+	"redis-challenge/internal/store"
+)
+
+type UnsubscribeValidator struct{}
+
+func (UnsubscribeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return UnsubscribeCommand{requestBytes: requestBytes, channels: values}, nil
+}
+
+// UnsubscribeCommand unsubscribes the connection from channels, or from every channel if none are given.
+type UnsubscribeCommand struct {
+	requestBytes []byte
+	channels     []string
+}
+
+func (cmd UnsubscribeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd UnsubscribeCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresSession
+}
+
+func (cmd UnsubscribeCommand) ExecuteInSession(session *Session) protocol.Data {
+	subscriber := session.Subscriber
+	return unsubscribe(subscriber, "unsubscribe", cmd.channels, subscriber.Channels(), subscriber.Count(), session.registry.Unsubscribe)
+}
+
+// unsubscribe removes the subscriptions named, or every subscription listed if none are named, replying to each
+// with the count of subscriptions left. With nothing to remove a single reply without a name is given with the count
+// of subscriptions.
+func unsubscribe(subscriber *pubsub.Subscriber, kind string, names []string, subscribed []string, count int, remove func(*pubsub.Subscriber, string) int) protocol.Data {
+	if len(names) == 0 {
+		names = subscribed
+	}
+	if len(names) == 0 {
+		return newSubscriptionData(kind, nil, count)
+	}
+
+	replies := make([]protocol.Data, len(names))
+	for i, name := range names {
+		remaining := remove(subscriber, name)
+		replies[i] = newSubscriptionData(kind, protocol.NewBulkString(name), remaining)
+	}
+	return protocol.NewReplies(replies)
+}
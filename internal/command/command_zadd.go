@@ -0,0 +1,136 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type ZAddValidator struct{}
+
+func (ZAddValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zadd")
+	}
+
+	cmd := ZAddCommand{requestBytes: requestBytes, key: values[0]}
+
+	scoreIndex := 1
+options:
+	for ; scoreIndex < len(values); scoreIndex++ {
+		switch strings.ToUpper(values[scoreIndex]) {
+		case "NX":
+			cmd.condition.OnlyMissing = true
+		case "XX":
+			cmd.condition.OnlyExisting = true
+		case "GT":
+			cmd.condition.OnlyGreater = true
+		case "LT":
+			cmd.condition.OnlyLess = true
+		case "CH":
+			cmd.countChanged = true
+		case "INCR":
+			cmd.increment = true
+		default:
+			break options
+		}
+	}
+
+	pairs := values[scoreIndex:]
+	if len(pairs) == 0 || len(pairs)%2 != 0 {
+		return nil, NewSyntaxError()
+	}
+
+	condition := cmd.condition
+	if condition.OnlyMissing && condition.OnlyExisting {
+		return nil, protocol.NewSimpleError("ERR XX and NX options at the same time are not compatible")
+	}
+	if (condition.OnlyGreater || condition.OnlyLess) && condition.OnlyMissing || condition.OnlyGreater && condition.OnlyLess {
+		return nil, protocol.NewSimpleError("ERR GT, LT, and/or NX options at the same time are not compatible")
+	}
+	if cmd.increment && len(pairs) > 2 {
+		return nil, protocol.NewSimpleError("ERR INCR option supports a single increment-element pair")
+	}
+
+	cmd.entries = make([]sortedset.Entry, len(pairs)/2)
+	for i := range cmd.entries {
+		score, ok := parseScore(pairs[2*i])
+		if !ok {
+			return nil, protocol.NewSimpleError("ERR value is not a valid float")
+		}
+		cmd.entries[i] = sortedset.Entry{Member: pairs[2*i+1], Score: score}
+	}
+
+	return cmd, nil
+}
+
+// parseScore parses a score of a sorted set, which can be infinite but not NaN.
+func parseScore(text string) (float64, bool) {
+	score, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(score) {
+		return 0, false
+	}
+	return score, true
+}
+
+type ZAddCommand struct {
+	requestBytes []byte
+	key          string
+	entries      []sortedset.Entry
+	condition    sortedset.Condition
+	countChanged bool
+	increment    bool
+}
+
+func (cmd ZAddCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZAddCommand) Execute(s store.Store) (protocol.Data, error) {
+	if cmd.increment {
+		return cmd.executeIncrement(s)
+	}
+
+	addedCount, updatedCount, err := s.SortedSetAdd(cmd.key, cmd.entries, cmd.condition)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.countChanged {
+		return protocol.NewSimpleInteger(addedCount + updatedCount), nil
+	}
+	return protocol.NewSimpleInteger(addedCount), nil
+}
+
+func (cmd ZAddCommand) executeIncrement(s store.Store) (protocol.Data, error) {
+	e := cmd.entries[0]
+	score, ok, err := s.SortedSetIncrement(cmd.key, e.Member, e.Score, cmd.condition)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if errors.Is(err, store.ErrorNotANumber) {
+		return protocol.NewSimpleError("ERR resulting score is not a number (NaN)"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, nil
+	}
+	return protocol.NewDouble(score), nil
+}
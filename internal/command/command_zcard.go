@@ -0,0 +1,47 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZCardValidator struct{}
+
+func (ZCardValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 1 {
+		return nil, NewWrongNumberOfArgumentsError("zcard")
+	}
+
+	return ZCardCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+	}, nil
+}
+
+type ZCardCommand struct {
+	requestBytes []byte
+	key          string
+}
+
+func (cmd ZCardCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZCardCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
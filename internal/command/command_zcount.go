@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+)
+
+type ZCountValidator struct{}
+
+func (ZCountValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("zcount")
+	}
+
+	scoreRange, errorData := parseScoreRange(values[1], values[2])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZCountCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		r:            scoreRange,
+	}, nil
+}
+
+type ZCountCommand struct {
+	requestBytes []byte
+	key          string
+	r            sortedset.Range
+}
+
+func (cmd ZCountCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZCountCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(members.CountInRange(cmd.r))), nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZDiffStoreValidator struct{}
+
+func (ZDiffStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zdiffstore")
+	}
+
+	inputs, errorData := parseAggregation("zdiffstore", values[1:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZDiffStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		inputs:       inputs,
+	}, nil
+}
+
+type ZDiffStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	inputs       aggregation
+}
+
+func (cmd ZDiffStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZDiffStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SortedSetDifference(cmd.inputs.keys)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.WriteSortedSet(cmd.destination, members, "zdiffstore")
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
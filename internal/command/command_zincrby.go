@@ -0,0 +1,60 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+)
+
+type ZIncrByValidator struct{}
+
+func (ZIncrByValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("zincrby")
+	}
+
+	increment, ok := parseScore(values[1])
+	if !ok {
+		return nil, protocol.NewSimpleError("ERR value is not a valid float")
+	}
+
+	return ZIncrByCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		increment:    increment,
+		member:       values[2],
+	}, nil
+}
+
+type ZIncrByCommand struct {
+	requestBytes []byte
+	key          string
+	increment    float64
+	member       string
+}
+
+func (cmd ZIncrByCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZIncrByCommand) Execute(s store.Store) (protocol.Data, error) {
+	score, _, err := s.SortedSetIncrement(cmd.key, cmd.member, cmd.increment, sortedset.Condition{})
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if errors.Is(err, store.ErrorNotANumber) {
+		return protocol.NewSimpleError("ERR resulting score is not a number (NaN)"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewDouble(score), nil
+}
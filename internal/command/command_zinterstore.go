@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZInterStoreValidator struct{}
+
+func (ZInterStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zinterstore")
+	}
+
+	inputs, errorData := parseAggregation("zinterstore", values[1:], true)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZInterStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		inputs:       inputs,
+	}, nil
+}
+
+type ZInterStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	inputs       aggregation
+}
+
+func (cmd ZInterStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZInterStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SortedSetIntersection(cmd.inputs.keys, cmd.inputs.weights, cmd.inputs.aggregate)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.WriteSortedSet(cmd.destination, members, "zinterstore")
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+)
+
+type ZLexCountValidator struct{}
+
+func (ZLexCountValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("zlexcount")
+	}
+
+	lexRange, errorData := parseLexRange(values[1], values[2])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZLexCountCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		r:            lexRange,
+	}, nil
+}
+
+type ZLexCountCommand struct {
+	requestBytes []byte
+	key          string
+	r            sortedset.Range
+}
+
+func (cmd ZLexCountCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZLexCountCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(int64(members.CountInRange(cmd.r))), nil
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type ZMPopValidator struct{}
+
+func (ZMPopValidator) Validate(_ []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zmpop")
+	}
+
+	numberOfKeys, err := strconv.Atoi(values[0])
+	if err != nil || numberOfKeys <= 0 {
+		return nil, protocol.NewSimpleError("ERR numkeys should be greater than 0")
+	}
+	if numberOfKeys > len(values)-2 {
+		return nil, NewSyntaxError()
+	}
+
+	cmd := &ZMPopCommand{keys: values[1 : numberOfKeys+1], count: 1}
+	switch strings.ToUpper(values[numberOfKeys+1]) {
+	case "MIN":
+	case "MAX":
+		cmd.fromMaximum = true
+	default:
+		return nil, NewSyntaxError()
+	}
+
+	options := values[numberOfKeys+2:]
+	var countGiven bool
+	for i := 0; i < len(options); i++ {
+		if strings.ToUpper(options[i]) != "COUNT" || i+1 == len(options) || countGiven {
+			return nil, NewSyntaxError()
+		}
+
+		i++
+		count, err := strconv.Atoi(options[i])
+		if err != nil || count <= 0 {
+			return nil, protocol.NewSimpleError("ERR count should be greater than 0")
+		}
+		cmd.count = count
+		countGiven = true
+	}
+
+	return cmd, nil
+}
+
+// ZMPopCommand pops from the first key holding a sorted set, so it is recorded in the command log as a ZPOPMIN or
+// ZPOPMAX of the key which was popped.
+type ZMPopCommand struct {
+	keys        []string
+	count       int
+	fromMaximum bool
+	poppedKey   string
+	popped      []sortedset.Entry
+}
+
+func (cmd *ZMPopCommand) Request() ([]byte, Type) {
+	if len(cmd.popped) == 0 {
+		return nil, TypeUpdate
+	}
+
+	name := "ZPOPMIN"
+	if cmd.fromMaximum {
+		name = "ZPOPMAX"
+	}
+	return encodeRequest(name, cmd.poppedKey, strconv.Itoa(cmd.count)), TypeUpdate
+}
+
+func (cmd *ZMPopCommand) Execute(s store.Store) (protocol.Data, error) {
+	for _, key := range cmd.keys {
+		popped, err := s.SortedSetPop(key, cmd.count, cmd.fromMaximum)
+
+		if errors.Is(err, store.ErrorWrongOperationType) {
+			return NewWrongOperationTypeError(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(popped) > 0 {
+			cmd.poppedKey = key
+			cmd.popped = popped
+			return newPoppedEntriesData(key, popped), nil
+		}
+	}
+	return protocol.NewNullArray(), nil
+}
+
+// newPoppedEntriesData returns the key with an array holding a member and score array for each entry.
+func newPoppedEntriesData(key string, entries []sortedset.Entry) protocol.Data {
+	data := make([]protocol.Data, len(entries))
+	for i, e := range entries {
+		data[i] = protocol.NewArray([]protocol.Data{
+			protocol.NewBulkString(e.Member),
+			protocol.NewDouble(e.Score),
+		})
+	}
+	return protocol.NewArray([]protocol.Data{
+		protocol.NewBulkString(key),
+		protocol.NewArray(data),
+	})
+}
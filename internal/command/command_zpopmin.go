@@ -0,0 +1,84 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+type ZPopMinValidator struct {
+	fromMaximum bool
+}
+
+func (v ZPopMinValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		if v.fromMaximum {
+			return nil, NewWrongNumberOfArgumentsError("zpopmax")
+		}
+		return nil, NewWrongNumberOfArgumentsError("zpopmin")
+	}
+	if len(values) > 2 {
+		return nil, NewSyntaxError()
+	}
+
+	cmd := ZPopMinCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		count:        1,
+		fromMaximum:  v.fromMaximum,
+	}
+	if len(values) == 2 {
+		count, err := strconv.Atoi(values[1])
+		if err != nil {
+			return nil, protocol.NewSimpleError("ERR value is not an integer or out of range")
+		}
+		if count < 0 {
+			return nil, protocol.NewSimpleError("ERR value is out of range, must be positive")
+		}
+		cmd.count = count
+		cmd.withCount = true
+	}
+
+	return cmd, nil
+}
+
+// ZPopMinCommand removes the members with the lowest scores, or with the highest scores for ZPOPMAX.
+type ZPopMinCommand struct {
+	requestBytes []byte
+	key          string
+	count        int
+	withCount    bool
+	fromMaximum  bool
+}
+
+func (cmd ZPopMinCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZPopMinCommand) Execute(s store.Store) (protocol.Data, error) {
+	popped, err := s.SortedSetPop(cmd.key, cmd.count, cmd.fromMaximum)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.withCount {
+		if len(popped) == 0 {
+			return protocol.NewArray([]protocol.Data{}), nil
+		}
+		return protocol.NewArray([]protocol.Data{
+			protocol.NewBulkString(popped[0].Member),
+			protocol.NewDouble(popped[0].Score),
+		}), nil
+	}
+	return newEntriesData(popped, true), nil
+}
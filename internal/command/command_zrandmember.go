@@ -0,0 +1,78 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type ZRandMemberValidator struct{}
+
+func (ZRandMemberValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 1 {
+		return nil, NewWrongNumberOfArgumentsError("zrandmember")
+	}
+	if len(values) > 3 {
+		return nil, NewSyntaxError()
+	}
+
+	cmd := ZRandMemberCommand{requestBytes: requestBytes, key: values[0], count: 1}
+	if len(values) >= 2 {
+		count, errorData := parseRandomCount(values[1])
+		if errorData != nil {
+			return nil, errorData
+		}
+		cmd.count = count
+		cmd.withCount = true
+	}
+	if len(values) == 3 {
+		if strings.ToUpper(values[2]) != "WITHSCORES" {
+			return nil, NewSyntaxError()
+		}
+		// the reply holds a score for every member, so its length must not overflow
+		if cmd.count < -math.MaxInt64/2 || cmd.count > math.MaxInt64/2 {
+			return nil, protocol.NewSimpleError("ERR value is out of range")
+		}
+		cmd.withScores = true
+	}
+
+	return cmd, nil
+}
+
+type ZRandMemberCommand struct {
+	requestBytes []byte
+	key          string
+	count        int
+	withCount    bool
+	withScores   bool
+}
+
+func (cmd ZRandMemberCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZRandMemberCommand) Execute(s store.Store) (protocol.Data, error) {
+	entries, err := s.SortedSetRandomEntries(cmd.key, cmd.count)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !cmd.withCount {
+		if len(entries) == 0 {
+			return nil, nil
+		}
+		return protocol.NewBulkString(entries[0].Member), nil
+	}
+	return newEntriesData(entries, cmd.withScores), nil
+}
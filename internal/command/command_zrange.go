@@ -0,0 +1,227 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type ZRangeValidator struct{}
+
+func (ZRangeValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zrange")
+	}
+
+	query, errorData := parseRangeQuery(values[1:], true)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZRangeCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		query:        query,
+	}, nil
+}
+
+type rangeType int
+
+const (
+	rangeByRank rangeType = iota
+	rangeByScore
+	rangeByLex
+)
+
+// rangeQuery selects entries of a sorted set by rank, score or member, as in the arguments of ZRANGE.
+type rangeQuery struct {
+	rangeType  rangeType
+	start      int
+	stop       int
+	r          sortedset.Range
+	reverse    bool
+	offset     int
+	count      int
+	withScores bool
+}
+
+// parseRangeQuery parses the start, stop and options of ZRANGE, where WITHSCORES is only allowed if the entries
+// are to be replied with.
+func parseRangeQuery(arguments []string, allowWithScores bool) (rangeQuery, protocol.Data) {
+	query := rangeQuery{count: -1}
+
+	hasRangeType := false
+	for i := 2; i < len(arguments); i++ {
+		remaining := len(arguments) - i - 1
+
+		switch option := strings.ToUpper(arguments[i]); {
+		case option == "WITHSCORES" && allowWithScores:
+			query.withScores = true
+		case option == "LIMIT" && remaining >= 2:
+			offset, err := strconv.Atoi(arguments[i+1])
+			if err != nil {
+				return rangeQuery{}, protocol.NewSimpleError("ERR value is not an integer or out of range")
+			}
+			count, err := strconv.Atoi(arguments[i+2])
+			if err != nil {
+				return rangeQuery{}, protocol.NewSimpleError("ERR value is not an integer or out of range")
+			}
+			query.offset, query.count = offset, count
+			i += 2
+		case option == "REV" && !query.reverse:
+			query.reverse = true
+		case option == "BYSCORE" && !hasRangeType:
+			query.rangeType, hasRangeType = rangeByScore, true
+		case option == "BYLEX" && !hasRangeType:
+			query.rangeType, hasRangeType = rangeByLex, true
+		default:
+			return rangeQuery{}, NewSyntaxError()
+		}
+	}
+
+	if query.count != -1 && query.rangeType == rangeByRank {
+		return rangeQuery{}, protocol.NewSimpleError("ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX")
+	}
+	if query.withScores && query.rangeType == rangeByLex {
+		return rangeQuery{}, protocol.NewSimpleError("ERR syntax error, WITHSCORES not supported in combination with BYLEX")
+	}
+
+	minimum, maximum := arguments[0], arguments[1]
+	if query.reverse && query.rangeType != rangeByRank {
+		minimum, maximum = maximum, minimum
+	}
+
+	var errorData protocol.Data
+	switch query.rangeType {
+	case rangeByScore:
+		query.r, errorData = parseScoreRange(minimum, maximum)
+	case rangeByLex:
+		query.r, errorData = parseLexRange(minimum, maximum)
+	default:
+		query.start, query.stop, errorData = parseRankRange(minimum, maximum)
+	}
+	if errorData != nil {
+		return rangeQuery{}, errorData
+	}
+
+	return query, nil
+}
+
+func (q rangeQuery) entries(members *sortedset.SortedSet) []sortedset.Entry {
+	if q.rangeType == rangeByRank {
+		return members.EntriesInRankRange(q.start, q.stop, q.reverse)
+	}
+	return members.EntriesInRange(q.r, q.reverse, q.offset, q.count)
+}
+
+func parseRankRange(start string, stop string) (int, int, protocol.Data) {
+	startRank, err := strconv.Atoi(start)
+	if err != nil {
+		return 0, 0, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	stopRank, err := strconv.Atoi(stop)
+	if err != nil {
+		return 0, 0, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	return startRank, stopRank, nil
+}
+
+// parseScoreRange parses the minimum and maximum scores of a range, which are exclusive when prefixed with "(".
+func parseScoreRange(minimum string, maximum string) (sortedset.ScoreRange, protocol.Data) {
+	minimumBound, minimumOk := parseScoreBound(minimum)
+	maximumBound, maximumOk := parseScoreBound(maximum)
+	if !minimumOk || !maximumOk {
+		return sortedset.ScoreRange{}, protocol.NewSimpleError("ERR min or max is not a float")
+	}
+	return sortedset.ScoreRange{Minimum: minimumBound, Maximum: maximumBound}, nil
+}
+
+func parseScoreBound(text string) (sortedset.ScoreBound, bool) {
+	var bound sortedset.ScoreBound
+	if strings.HasPrefix(text, "(") {
+		bound.Exclusive = true
+		text = text[1:]
+	}
+
+	score, err := strconv.ParseFloat(text, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) || math.IsNaN(score) {
+		return sortedset.ScoreBound{}, false
+	}
+	bound.Score = score
+	return bound, true
+}
+
+// parseLexRange parses the minimum and maximum members of a range, which are prefixed with "[" when inclusive and
+// "(" when exclusive, or are "-" and "+" for before and after every member.
+func parseLexRange(minimum string, maximum string) (sortedset.LexRange, protocol.Data) {
+	minimumBound, minimumOk := parseLexBound(minimum)
+	maximumBound, maximumOk := parseLexBound(maximum)
+	if !minimumOk || !maximumOk {
+		return sortedset.LexRange{}, protocol.NewSimpleError("ERR min or max not valid string range item")
+	}
+	return sortedset.LexRange{Minimum: minimumBound, Maximum: maximumBound}, nil
+}
+
+func parseLexBound(text string) (sortedset.LexBound, bool) {
+	switch {
+	case text == "-":
+		return sortedset.LexBound{Infinite: -1}, true
+	case text == "+":
+		return sortedset.LexBound{Infinite: 1}, true
+	case strings.HasPrefix(text, "("):
+		return sortedset.LexBound{Member: text[1:], Exclusive: true}, true
+	case strings.HasPrefix(text, "["):
+		return sortedset.LexBound{Member: text[1:]}, true
+	default:
+		return sortedset.LexBound{}, false
+	}
+}
+
+type ZRangeCommand struct {
+	requestBytes []byte
+	key          string
+	query        rangeQuery
+}
+
+func (cmd ZRangeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZRangeCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newEntriesData(cmd.query.entries(members), cmd.query.withScores), nil
+}
+
+// newEntriesData returns the members of the entries, paired with their scores if requested.
+func newEntriesData(entries []sortedset.Entry, withScores bool) protocol.Data {
+	if withScores {
+		pairs := make([]protocol.MapEntry, len(entries))
+		for i, e := range entries {
+			pairs[i] = protocol.MapEntry{Key: protocol.NewBulkString(e.Member), Value: protocol.NewDouble(e.Score)}
+		}
+		return protocol.NewPairs(pairs)
+	}
+
+	data := make([]protocol.Data, len(entries))
+	for i, e := range entries {
+		data[i] = protocol.NewBulkString(e.Member)
+	}
+	return protocol.NewArray(data)
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+)
+
+type ZRangeStoreValidator struct{}
+
+func (ZRangeStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 4 {
+		return nil, NewWrongNumberOfArgumentsError("zrangestore")
+	}
+
+	query, errorData := parseRangeQuery(values[2:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZRangeStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		source:       values[1],
+		query:        query,
+	}, nil
+}
+
+type ZRangeStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	source       string
+	query        rangeQuery
+}
+
+func (cmd ZRangeStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZRangeStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.source)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stored := sortedset.New()
+	for _, e := range cmd.query.entries(members) {
+		stored.Add(e.Member, e.Score)
+	}
+
+	s.WriteSortedSet(cmd.destination, stored, "zrangestore")
+	return protocol.NewSimpleInteger(int64(stored.Len())), nil
+}
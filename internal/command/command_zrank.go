@@ -0,0 +1,87 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strings"
+)
+
+type ZRankValidator struct {
+	reverse bool
+}
+
+func (v ZRankValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 || len(values) > 3 {
+		if v.reverse {
+			return nil, NewWrongNumberOfArgumentsError("zrevrank")
+		}
+		return nil, NewWrongNumberOfArgumentsError("zrank")
+	}
+
+	cmd := ZRankCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		member:       values[1],
+		reverse:      v.reverse,
+	}
+
+	if len(values) == 3 {
+		if strings.ToUpper(values[2]) != "WITHSCORE" {
+			return nil, NewSyntaxError()
+		}
+		cmd.withScore = true
+	}
+
+	return cmd, nil
+}
+
+// ZRankCommand returns the rank of a member ordered from the lowest score, or from the highest score for ZREVRANK.
+type ZRankCommand struct {
+	requestBytes []byte
+	key          string
+	member       string
+	reverse      bool
+	withScore    bool
+}
+
+func (cmd ZRankCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZRankCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rank, ok := members.Rank(cmd.member)
+	if !ok {
+		if cmd.withScore {
+			return protocol.NewNullArray(), nil
+		}
+		return nil, nil
+	}
+
+	if cmd.reverse {
+		rank = members.Len() - 1 - rank
+	}
+
+	if cmd.withScore {
+		score, _ := members.Score(cmd.member)
+		return protocol.NewArray([]protocol.Data{
+			protocol.NewSimpleInteger(int64(rank)),
+			protocol.NewDouble(score),
+		}), nil
+	}
+	return protocol.NewSimpleInteger(int64(rank)), nil
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZRemValidator struct{}
+
+func (ZRemValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("zrem")
+	}
+
+	return ZRemCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		members:      values[1:],
+	}, nil
+}
+
+type ZRemCommand struct {
+	requestBytes []byte
+	key          string
+	members      []string
+}
+
+func (cmd ZRemCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZRemCommand) Execute(s store.Store) (protocol.Data, error) {
+	count, err := s.SortedSetRemove(cmd.key, cmd.members, "zrem")
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.NewSimpleInteger(count), nil
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"redis-challenge/internal/protocol"
+)
+
+type ZRemRangeByLexValidator struct{}
+
+func (ZRemRangeByLexValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("zremrangebylex")
+	}
+
+	lexRange, errorData := parseLexRange(values[1], values[2])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZRemRangeCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		query:        rangeQuery{rangeType: rangeByLex, r: lexRange, count: -1},
+	}, nil
+}
@@ -0,0 +1,85 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+)
+
+type ZRemRangeByScoreValidator struct{}
+
+func (ZRemRangeByScoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 3 {
+		return nil, NewWrongNumberOfArgumentsError("zremrangebyscore")
+	}
+
+	scoreRange, errorData := parseScoreRange(values[1], values[2])
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZRemRangeCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		query:        rangeQuery{rangeType: rangeByScore, r: scoreRange, count: -1},
+	}, nil
+}
+
+// ZRemRangeCommand removes the entries of a sorted set selected by rank, score or member.
+type ZRemRangeCommand struct {
+	requestBytes []byte
+	key          string
+	query        rangeQuery
+}
+
+func (cmd ZRemRangeCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZRemRangeCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := cmd.query.entries(members)
+	if len(entries) == 0 {
+		return protocol.NewSimpleInteger(0), nil
+	}
+
+	count, err := s.SortedSetRemove(cmd.key, entryMembers(entries), cmd.event())
+	if err != nil {
+		return nil, err
+	}
+	return protocol.NewSimpleInteger(count), nil
+}
+
+// event returns the name of the keyspace event of the removal, which names how the entries were selected.
+func (cmd ZRemRangeCommand) event() string {
+	switch cmd.query.rangeType {
+	case rangeByRank:
+		return "zrembyrank"
+	case rangeByLex:
+		return "zrembylex"
+	default:
+		return "zrembyscore"
+	}
+}
+
+func entryMembers(entries []sortedset.Entry) []string {
+	members := make([]string, len(entries))
+	for i, e := range entries {
+		members[i] = e.Member
+	}
+	return members
+}
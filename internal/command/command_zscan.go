@@ -0,0 +1,60 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZScanValidator struct{}
+
+func (ZScanValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 2 {
+		return nil, NewWrongNumberOfArgumentsError("zscan")
+	}
+
+	query, errorData := parseScanQuery(values[1:], false)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZScanCommand{requestBytes: requestBytes, key: values[0], query: query}, nil
+}
+
+// ZScanCommand returns a page of the members of a sorted set from a cursor, each followed by its score.
+type ZScanCommand struct {
+	requestBytes []byte
+	key          string
+	query        scanQuery
+}
+
+func (cmd ZScanCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZScanCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	next, page := members.Scan(cmd.query.cursor, cmd.query.count)
+
+	found := make([]protocol.Data, 0, 2*len(page))
+	for _, member := range page {
+		if cmd.query.matches(member) {
+			score, _ := members.Score(member)
+			found = append(found, protocol.NewBulkString(member), protocol.NewBulkString(protocol.FormatDouble(score)))
+		}
+	}
+	return newScanData(next, protocol.NewArray(found)), nil
+}
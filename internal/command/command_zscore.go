@@ -0,0 +1,52 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+type ZScoreValidator struct{}
+
+func (ZScoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) != 2 {
+		return nil, NewWrongNumberOfArgumentsError("zscore")
+	}
+
+	return ZScoreCommand{
+		requestBytes: requestBytes,
+		key:          values[0],
+		member:       values[1],
+	}, nil
+}
+
+type ZScoreCommand struct {
+	requestBytes []byte
+	key          string
+	member       string
+}
+
+func (cmd ZScoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeRead
+}
+
+func (cmd ZScoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.ReadSortedSet(cmd.key)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if score, ok := members.Score(cmd.member); ok {
+		return protocol.NewDouble(score), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+	"strconv"
+	"strings"
+)
+
+type ZUnionStoreValidator struct{}
+
+func (ZUnionStoreValidator) Validate(requestBytes []byte, arguments []protocol.Data) (Command, protocol.Data) {
+	values, errorData := parseBulkStrings(arguments)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	if len(values) < 3 {
+		return nil, NewWrongNumberOfArgumentsError("zunionstore")
+	}
+
+	inputs, errorData := parseAggregation("zunionstore", values[1:], true)
+	if errorData != nil {
+		return nil, errorData
+	}
+
+	return ZUnionStoreCommand{
+		requestBytes: requestBytes,
+		destination:  values[0],
+		inputs:       inputs,
+	}, nil
+}
+
+// aggregation is the input keys of ZUNIONSTORE, ZINTERSTORE and ZDIFFSTORE with the weight for each key and how
+// the scores of a member are combined.
+type aggregation struct {
+	keys      []string
+	weights   []float64
+	aggregate sortedset.Aggregate
+}
+
+// parseAggregation reads the number of keys, the keys and then the WEIGHTS and AGGREGATE options if they are
+// allowed.
+func parseAggregation(name string, arguments []string, allowOptions bool) (aggregation, protocol.Data) {
+	numberOfKeys, err := strconv.Atoi(arguments[0])
+	if err != nil {
+		return aggregation{}, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	if numberOfKeys < 1 {
+		return aggregation{}, protocol.NewSimpleError(fmt.Sprintf("ERR at least 1 input key is needed for '%s' command", name))
+	}
+	if numberOfKeys > len(arguments)-1 {
+		return aggregation{}, NewSyntaxError()
+	}
+
+	inputs := aggregation{
+		keys:      arguments[1 : numberOfKeys+1],
+		weights:   make([]float64, numberOfKeys),
+		aggregate: sortedset.AggregateSum,
+	}
+	for i := range inputs.weights {
+		inputs.weights[i] = 1
+	}
+
+	options := arguments[numberOfKeys+1:]
+	for i := 0; i < len(options); i++ {
+		remaining := len(options) - i
+		switch option := strings.ToUpper(options[i]); {
+		case allowOptions && option == "WEIGHTS" && remaining > numberOfKeys:
+			for j := range inputs.weights {
+				i++
+				weight, ok := parseScore(options[i])
+				if !ok {
+					return aggregation{}, protocol.NewSimpleError("ERR weight value is not a float")
+				}
+				inputs.weights[j] = weight
+			}
+		case allowOptions && option == "AGGREGATE" && remaining > 1:
+			i++
+			switch strings.ToUpper(options[i]) {
+			case "SUM":
+				inputs.aggregate = sortedset.AggregateSum
+			case "MIN":
+				inputs.aggregate = sortedset.AggregateMinimum
+			case "MAX":
+				inputs.aggregate = sortedset.AggregateMaximum
+			default:
+				return aggregation{}, NewSyntaxError()
+			}
+		default:
+			return aggregation{}, NewSyntaxError()
+		}
+	}
+
+	return inputs, nil
+}
+
+type ZUnionStoreCommand struct {
+	requestBytes []byte
+	destination  string
+	inputs       aggregation
+}
+
+func (cmd ZUnionStoreCommand) Request() ([]byte, Type) {
+	return cmd.requestBytes, TypeUpdate
+}
+
+func (cmd ZUnionStoreCommand) Execute(s store.Store) (protocol.Data, error) {
+	members, err := s.SortedSetUnion(cmd.inputs.keys, cmd.inputs.weights, cmd.inputs.aggregate)
+
+	if errors.Is(err, store.ErrorWrongOperationType) {
+		return NewWrongOperationTypeError(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.WriteSortedSet(cmd.destination, members, "zunionstore")
+	return protocol.NewSimpleInteger(int64(members.Len())), nil
+}
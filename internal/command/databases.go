@@ -0,0 +1,41 @@
+package command
+
+import (
+	"errors"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
+
+var ErrorRequiresDatabases = errors.New("command must be executed against the databases")
+
+// DatabasesCommand is a command that acts on more than the selected database, such as moving a key to another
+// database.
+type DatabasesCommand interface {
+	Command
+	ExecuteOnDatabases(databases *store.Databases, selected int) (protocol.Data, error)
+}
+
+// ExecuteInDatabase executes the command against the selected database, or against the databases as a whole if the
+// command acts on more than one.
+func ExecuteInDatabase(cmd Command, databases *store.Databases, selected int) (protocol.Data, error) {
+	if databasesCommand, ok := cmd.(DatabasesCommand); ok {
+		return databasesCommand.ExecuteOnDatabases(databases, selected)
+	}
+	return cmd.Execute(databases.Store(selected))
+}
+
+// parseDatabaseIndex parses the index of a database, which is only checked against the number of databases when
+// the command executes.
+func parseDatabaseIndex(value string) (int, protocol.Data) {
+	index, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+	return index, nil
+}
+
+// isDatabaseInRange returns true if there is a database with the index.
+func isDatabaseInRange(index int, count int) bool {
+	return index >= 0 && index < count
+}
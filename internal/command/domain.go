@@ -3,6 +3,7 @@ package command
 import (
 	"redis-challenge/internal/protocol"
 	"redis-challenge/internal/store"
+	"slices"
 )
 
 type Type string
@@ -13,6 +14,8 @@ const (
 )
 
 type Command interface {
+	// Request returns the request to record in the command log. It is read after the command has executed,
+	// so commands with a random outcome can record a request which replays the same outcome.
 	Request() ([]byte, Type)
 	Execute(s store.Store) (protocol.Data, error)
 }
@@ -30,6 +33,10 @@ func FromData(data protocol.Data) (Data, protocol.Data) {
 
 		nameData := array.Data[0]
 
+		if slices.Contains(array.Data, nil) {
+			return Data{}, protocol.NewSimpleError("ERR Protocol error: invalid bulk length")
+		}
+
 		if name, ok := nameData.(protocol.BulkString); ok {
 			return Data{
 				Name:      string(name),
@@ -77,6 +77,16 @@ func TestReadingCommands(t *testing.T) {
 		assert.Equal(t, protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"), err)
 	})
 
+	t.Run("data with a null argument should error", func(t *testing.T) {
+		data := protocol.Array{Data: []protocol.Data{
+			protocol.NewBulkString("ECHO"),
+			nil,
+		}}
+
+		_, err := command.FromData(data)
+		assert.Equal(t, protocol.NewSimpleError("ERR Protocol error: invalid bulk length"), err)
+	})
+
 	t.Run("data is not an array should error", func(t *testing.T) {
 		data := protocol.NewBulkString("PING")
 
@@ -16,3 +16,15 @@ func NewSyntaxError() protocol.SimpleError {
 func NewWrongOperationTypeError() protocol.SimpleError {
 	return protocol.NewSimpleError(fmt.Sprintf("WRONGTYPE Operation against a key holding the wrong kind of value"))
 }
+
+func NewWrongNumberOfArgumentsError(commandName string) protocol.SimpleError {
+	return protocol.NewSimpleError(fmt.Sprintf("ERR wrong number of arguments for '%s' command", commandName))
+}
+
+func NewDatabaseOutOfRangeError() protocol.SimpleError {
+	return protocol.NewSimpleError("ERR DB index is out of range")
+}
+
+func NewCrossSlotError() protocol.SimpleError {
+	return protocol.NewSimpleError("CROSSSLOT Keys in request don't hash to the same slot")
+}
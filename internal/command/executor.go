@@ -2,7 +2,7 @@ package command
 
 import (
 	"context"
-	"io"
+	"errors"
 	"log/slog"
 	"redis-challenge/internal/protocol"
 	"redis-challenge/internal/store"
@@ -10,7 +10,10 @@ import (
 )
 
 type Executor interface {
-	Execute(cmd Command, responses chan<- protocol.Data, errors chan<- error)
+	// Execute executes the command against the database selected by the client.
+	Execute(cmd Command, database int, responses chan<- protocol.Data, errors chan<- error)
+	// Cancel stops a blocking command waiting, such as when its client disconnects before it is served.
+	Cancel(cmd Command)
 }
 
 type Scanner interface {
@@ -18,22 +21,30 @@ type Scanner interface {
 }
 
 type execution struct {
-	cmd      Command
-	request  []byte
-	scan     Scanner
-	errors   chan<- error
-	response chan<- protocol.Data
-	writer   io.Writer
+	cmd           Command
+	database      int
+	request       []byte
+	scan          Scanner
+	errors        chan<- error
+	response      chan<- protocol.Data
+	cancel        Command
+	checkTimeouts store.Clock
 }
 
 const callsToStoreQueueSize = 1000
 
-func NewStoreExecutor(ctx context.Context, s store.Store, scanner Scanner, writer io.Writer) Executor {
+// blockedTimeoutCheckInterval is how often blocked commands are checked against their deadline, which bounds how
+// late a timeout is replied to.
+const blockedTimeoutCheckInterval = 10 * time.Millisecond
+
+func NewStoreExecutor(ctx context.Context, databases *store.Databases, clock store.Clock) Executor {
 	executionChannel := make(chan execution, callsToStoreQueueSize)
 
-	go triggerRepeatedExpiryScan(ctx, executionChannel, scanner)
+	go triggerRepeatedExpiryScan(ctx, executionChannel, databases)
+
+	go triggerRepeatedTimeoutCheck(ctx, executionChannel, clock)
 
-	go executeCommandsAgainstStore(ctx, executionChannel, s, writer)
+	go executeCommandsAgainstStore(ctx, executionChannel, databases)
 
 	return storeExecutor{executionChannel: executionChannel}
 }
@@ -52,7 +63,23 @@ func triggerRepeatedExpiryScan(ctx context.Context, executionChannel chan<- exec
 	}
 }
 
-func executeCommandsAgainstStore(ctx context.Context, executionChannel <-chan execution, s store.Store, writer io.Writer) {
+func triggerRepeatedTimeoutCheck(ctx context.Context, executionChannel chan<- execution, clock store.Clock) {
+	ticker := time.NewTicker(blockedTimeoutCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			executionChannel <- execution{checkTimeouts: clock}
+		}
+	}
+}
+
+func executeCommandsAgainstStore(ctx context.Context, executionChannel <-chan execution, databases *store.Databases) {
+	var blocked blockedExecutions
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -61,31 +88,121 @@ func executeCommandsAgainstStore(ctx context.Context, executionChannel <-chan ex
 			switch {
 			case e.scan != nil:
 				e.scan.Scan()
+			case e.checkTimeouts != nil:
+				for _, w := range blocked.expire(e.checkTimeouts.Now()) {
+					w.response <- w.cmd.TimeoutResponse()
+				}
+			case e.cancel != nil:
+				blocked.remove(e.cancel)
 			case e.cmd != nil:
-				if request, commandType := e.cmd.Request(); commandType == TypeUpdate {
-					_, err := writer.Write(request)
-					if err != nil {
-						slog.Error("failed to write request", "error", err, "request", string(request))
+				if transaction, ok := e.cmd.(TransactionCommand); ok {
+					if !completeTransaction(e, transaction, databases) || !serveBlocked(&blocked, databases) {
 						return
 					}
+					continue
 				}
 
-				data, err := e.cmd.Execute(s)
-				if err != nil {
-					e.errors <- err
+				databases.Log().BeginCommand()
+				data, err := ExecuteInDatabase(e.cmd, databases, e.database)
+				if blockingCommand, ok := e.cmd.(BlockingCommand); ok && errors.Is(err, ErrorBlocked) {
+					if !logDeletes(databases.Log()) {
+						return
+					}
+					blocked.add(e, blockingCommand)
 					continue
 				}
 
-				e.response <- data
+				if !complete(e, data, err, databases.Log()) {
+					return
+				}
+
+				if _, commandType := e.cmd.Request(); commandType == TypeUpdate && !serveBlocked(&blocked, databases) {
+					return
+				}
 			}
 		}
 	}
 }
 
+// serveBlocked completes blocked commands in the order they arrived while any of them has a key holding a list,
+// returning false if the command log could not be written.
+func serveBlocked(blocked *blockedExecutions, databases *store.Databases) bool {
+	for {
+		w, key, ok := blocked.next(databases)
+		if !ok {
+			return true
+		}
+
+		databases.Log().BeginCommand()
+		data, err := w.cmd.ExecuteOnKey(databases.Store(w.database), key)
+		if !complete(w.execution, data, err, databases.Log()) {
+			return false
+		}
+	}
+}
+
+// complete writes an executed command to the command log, after the deletes of any keys it found expired, and sends
+// its response, returning false if the command log could not be written.
+func complete(e execution, data protocol.Data, err error, log *store.CommandLog) bool {
+	if err != nil {
+		if !logDeletes(log) {
+			return false
+		}
+		e.errors <- err
+		return true
+	}
+
+	var request []byte
+	if updateRequest, commandType := e.cmd.Request(); commandType == TypeUpdate {
+		request = updateRequest
+	}
+	if err := log.EndCommand(e.database, request); err != nil {
+		slog.Error("failed to write request", "error", err, "request", string(request))
+		return false
+	}
+
+	e.response <- data
+	return true
+}
+
+// logDeletes writes the deletes of keys found expired by a command which has no request to log, returning false if
+// the command log could not be written.
+func logDeletes(log *store.CommandLog) bool {
+	if err := log.EndCommand(0, nil); err != nil {
+		slog.Error("failed to write deletes", "error", err)
+		return false
+	}
+	return true
+}
+
+// completeTransaction executes the commands of the transaction with nothing running between them, writing their
+// updates to the command log as a single block before sending the replies, and returns false if the command log
+// could not be written.
+func completeTransaction(e execution, transaction TransactionCommand, databases *store.Databases) bool {
+	log := databases.Log()
+	log.BeginTransaction()
+
+	data, err := transaction.ExecuteTransaction(databases, log)
+	if err == nil {
+		err = log.EndTransaction()
+	}
+	if err != nil {
+		slog.Error("failed to write transaction", "error", err)
+		return false
+	}
+
+	e.response <- data
+	return true
+}
+
 type storeExecutor struct {
 	executionChannel chan<- execution
 }
 
-func (executor storeExecutor) Execute(cmd Command, responses chan<- protocol.Data, errors chan<- error) {
-	executor.executionChannel <- execution{cmd: cmd, errors: errors, response: responses}
+func (executor storeExecutor) Execute(cmd Command, database int, responses chan<- protocol.Data, errors chan<- error) {
+	executor.executionChannel <- execution{cmd: cmd, database: database, errors: errors, response: responses}
+}
+
+func (executor storeExecutor) Cancel(cmd Command) {
+	executor.executionChannel <- execution{cancel: cmd}
 }
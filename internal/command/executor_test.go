@@ -0,0 +1,192 @@
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/command"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"testing"
+	"time"
+)
+
+func TestExecutingBlockingCommands(t *testing.T) {
+
+	const waitForResponse = time.Second
+
+	validate := func(t *testing.T, validator command.Validator, arguments ...string) command.Command {
+		request := protocol.Array{}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		cmd, errorData := validator.Validate(nil, request)
+		require.Nil(t, errorData)
+		return cmd
+	}
+
+	newExecutor := func(t *testing.T, clock store.Clock) command.Executor {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		return command.NewStoreExecutor(ctx, store.NewBuilder().WithClock(clock).Build(), clock)
+	}
+
+	executeIn := func(executor command.Executor, database int, cmd command.Command) <-chan protocol.Data {
+		responses := make(chan protocol.Data, 1)
+		executor.Execute(cmd, database, responses, make(chan error, 1))
+		return responses
+	}
+
+	execute := func(executor command.Executor, cmd command.Command) <-chan protocol.Data {
+		return executeIn(executor, 0, cmd)
+	}
+
+	receive := func(t *testing.T, responses <-chan protocol.Data) protocol.Data {
+		select {
+		case response := <-responses:
+			return response
+		case <-time.After(waitForResponse):
+			require.Fail(t, "no response received")
+			return nil
+		}
+	}
+
+	t.Run("a cancelled command no longer waits for its keys", func(t *testing.T) {
+		// Given a command waiting on a key
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		validator := command.NewValidator(clock)
+		executor := newExecutor(t, clock)
+
+		blocked := validate(t, validator, "BLPOP", "key", "0")
+		blockedResponses := execute(executor, blocked)
+
+		// When it is cancelled before a value is pushed
+		executor.Cancel(blocked)
+		receive(t, execute(executor, validate(t, validator, "RPUSH", "key", "a")))
+
+		// Then the value is left in the list
+		assert.Equal(t, protocol.NewSimpleInteger(1), receive(t, execute(executor, validate(t, validator, "LLEN", "key"))))
+		assert.Empty(t, blockedResponses)
+	})
+
+	t.Run("a command waiting past its deadline responds with its timeout response", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		validator := command.NewValidator(clock)
+		executor := newExecutor(t, clock)
+
+		responses := execute(executor, validate(t, validator, "BLMOVE", "source", "destination", "LEFT", "LEFT", "0.1"))
+		receive(t, execute(executor, validate(t, validator, "PING")))
+		clock.AddMilliseconds(100)
+
+		assert.Nil(t, receive(t, responses))
+	})
+	t.Run("a command waiting in one database is not served by a push in another", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		validator := command.NewValidator(clock)
+		executor := newExecutor(t, clock)
+
+		blockedResponses := executeIn(executor, 1, validate(t, validator, "BLPOP", "key", "0"))
+		receive(t, execute(executor, validate(t, validator, "RPUSH", "key", "a")))
+		assert.Empty(t, blockedResponses)
+
+		receive(t, executeIn(executor, 1, validate(t, validator, "RPUSH", "key", "b")))
+		assert.Equal(t, protocol.NewArray([]protocol.Data{protocol.NewBulkString("key"), protocol.NewBulkString("b")}), receive(t, blockedResponses))
+	})
+}
+
+func TestLoggingExecutedCommands(t *testing.T) {
+
+	const waitForResponse = time.Second
+
+	type harness struct {
+		clock     *store.FixedClock
+		validator command.Validator
+		executor  command.Executor
+		log       *bytes.Buffer
+	}
+
+	newHarness := func(t *testing.T) harness {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		log := bytes.NewBuffer(nil)
+		databases := store.NewBuilder().WithClock(clock).WithCommandLogWriter(log).Build()
+		return harness{
+			clock:     clock,
+			validator: command.NewValidator(clock),
+			executor:  command.NewStoreExecutor(ctx, databases, clock),
+			log:       log,
+		}
+	}
+
+	encode := func(arguments ...string) string {
+		request := protocol.Array{}
+		for _, argument := range arguments {
+			request.Data = append(request.Data, protocol.NewBulkString(argument))
+		}
+
+		buffer := bytes.NewBuffer(nil)
+		_ = protocol.WriteData(buffer, request)
+		return buffer.String()
+	}
+
+	execute := func(t *testing.T, h harness, arguments ...string) {
+		request := encode(arguments...)
+		requestData, _ := protocol.ReadFrame([]byte(request))
+		cmd, errorData := h.validator.Validate([]byte(request), requestData)
+		require.Nil(t, errorData)
+
+		responses := make(chan protocol.Data, 1)
+		h.executor.Execute(cmd, 0, responses, make(chan error, 1))
+		select {
+		case <-responses:
+		case <-time.After(waitForResponse):
+			require.Fail(t, "no response received")
+		}
+	}
+
+	t.Run("a key found expired by a command is logged as deleted before the command", func(t *testing.T) {
+		// Given a key which has expired
+		h := newHarness(t)
+		execute(t, h, "SET", "key", "value", "PX", "10")
+		h.clock.AddMilliseconds(20)
+
+		// When a command replaces it
+		execute(t, h, "RPUSH", "key", "a")
+
+		// Then the delete is replayed before the push
+		assert.Equal(t, encode("SELECT", "0")+
+			encode("SET", "key", "value", "PXAT", "1010")+
+			encode("DEL", "key")+
+			encode("RPUSH", "key", "a"), h.log.String())
+	})
+
+	t.Run("a key with an expiry replaced by a command is logged only as the command", func(t *testing.T) {
+		h := newHarness(t)
+		execute(t, h, "SADD", "key", "1")
+		execute(t, h, "PEXPIRE", "key", "100")
+
+		execute(t, h, "SUNIONSTORE", "key", "key", "other")
+
+		assert.Equal(t, encode("SELECT", "0")+
+			encode("SADD", "key", "1")+
+			encode("PEXPIREAT", "key", "1100")+
+			encode("SUNIONSTORE", "key", "key", "other"), h.log.String())
+	})
+
+	t.Run("a key found expired by a command which is not logged is still logged as deleted", func(t *testing.T) {
+		h := newHarness(t)
+		execute(t, h, "SET", "key", "value", "PX", "10")
+		h.clock.AddMilliseconds(20)
+
+		execute(t, h, "GET", "key")
+
+		assert.Equal(t, encode("SELECT", "0")+
+			encode("SET", "key", "value", "PXAT", "1010")+
+			encode("DEL", "key"), h.log.String())
+	})
+}
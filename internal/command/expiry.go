@@ -1,6 +1,12 @@
 package command
 
-import "redis-challenge/internal/store"
+import (
+	"fmt"
+	"math"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+	"strconv"
+)
 
 func ExpiryTimestamp(clock store.Clock, option store.ExpiryOption, expiry int64) (store.ExpiryOption, int64) {
 	if option == store.ExpiryOptionNone || option == store.ExpiryOptionExpiryKeepTTL {
@@ -22,3 +28,49 @@ func ExpiryTimestamp(clock store.Clock, option store.ExpiryOption, expiry int64)
 
 	return store.ExpiryOptionExpiryUnixTimeInMilliseconds, timestamp
 }
+
+// expiryFromNow returns the time in milliseconds when a relative expiry ends counting from now on the clock,
+// returning false if it overflows.
+func expiryFromNow(clock store.Clock, milliseconds int64) (int64, bool) {
+	now := clock.Now()
+	if milliseconds > math.MaxInt64-now {
+		return 0, false
+	}
+	return now + milliseconds, true
+}
+
+// parsePositiveExpiry parses the expiry for the option in milliseconds, leaving a relative expiry to be counted from
+// when the command is executed, and rejects expiries that are not positive or that overflow, as commands like SETEX
+// and GETEX do.
+func parsePositiveExpiry(clock store.Clock, name string, option store.ExpiryOption, text string) (int64, protocol.Data) {
+	expiry, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return 0, protocol.NewSimpleError("ERR value is not an integer or out of range")
+	}
+
+	invalid := protocol.NewSimpleError(fmt.Sprintf("ERR invalid expire time in '%s' command", name))
+	if expiry <= 0 {
+		return 0, invalid
+	}
+
+	inSeconds := option == store.ExpiryOptionExpirySeconds || option == store.ExpiryOptionExpiryUnixTimeInSeconds
+	if inSeconds {
+		if expiry > math.MaxInt64/1000 {
+			return 0, invalid
+		}
+		expiry *= 1000
+	}
+
+	// a relative expiry which overflows now overflows whenever it is executed
+	if isRelativeExpiry(option) {
+		if _, ok := expiryFromNow(clock, expiry); !ok {
+			return 0, invalid
+		}
+	}
+	return expiry, nil
+}
+
+// isRelativeExpiry returns true if the expiry of the option counts from now rather than being a time.
+func isRelativeExpiry(option store.ExpiryOption) bool {
+	return option == store.ExpiryOptionExpirySeconds || option == store.ExpiryOptionExpiryMilliseconds
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"fmt"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
+	"strings"
+	"sync/atomic"
+)
+
+var lastSessionId atomic.Int64
+
+// Session holds the state of a single client connection.
+type Session struct {
+	Id              int64
+	ProtocolVersion protocol.Version
+	Name            string
+	Database        int
+	Subscriber      *pubsub.Subscriber
+	databaseCount   int
+	registry        *pubsub.Registry
+	transaction     *transaction
+}
+
+// NewSession returns the state of a new connection, which starts with database zero of the databases selected and
+// subscribes through the registry.
+func NewSession(databaseCount int, registry *pubsub.Registry) *Session {
+	return &Session{
+		Id:              lastSessionId.Add(1),
+		ProtocolVersion: protocol.Version2,
+		Subscriber:      pubsub.NewSubscriber(),
+		databaseCount:   databaseCount,
+		registry:        registry,
+	}
+}
+
+// IsSubscribed returns true if the connection is subscribed to any channel, pattern or shard channel.
+func (s *Session) IsSubscribed() bool {
+	return s.Subscriber.Count() > 0 || s.Subscriber.ShardCount() > 0
+}
+
+// CheckSubscribedContext returns an error if the command is not allowed on the connection because it is subscribed
+// using RESP2, where replies could not be told apart from messages, unless the command manages subscriptions or is
+// PING.
+func (s *Session) CheckSubscribedContext(cmd Command, name string) protocol.Data {
+	if s.ProtocolVersion != protocol.Version2 || !s.IsSubscribed() {
+		return nil
+	}
+
+	switch cmd.(type) {
+	case SubscribeCommand, UnsubscribeCommand, PSubscribeCommand, PUnsubscribeCommand, SSubscribeCommand, SUnsubscribeCommand, PingCommand:
+		return nil
+	default:
+		return protocol.NewSimpleError(fmt.Sprintf(
+			"ERR Can't execute '%s': only (P|S)SUBSCRIBE / (P|S)UNSUBSCRIBE / PING / QUIT / RESET are allowed in this context",
+			strings.ToLower(name)))
+	}
+}
+
+// SessionCommand is a command that acts on the session of the connection it was received on rather than the store.
+type SessionCommand interface {
+	Command
+	ExecuteInSession(session *Session) protocol.Data
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"errors"
+	"log/slog"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/store"
+)
+
+var ErrorRequiresTransaction = errors.New("command must be executed as a transaction")
+
+// transaction holds the commands a connection queued since MULTI.
+type transaction struct {
+	queued []Command
+	// failed is set when a command could not be queued, so that EXEC discards the transaction.
+	failed bool
+}
+
+// InTransaction returns true if the connection is queuing commands between MULTI and EXEC or DISCARD.
+func (s *Session) InTransaction() bool {
+	return s.transaction != nil
+}
+
+// Queue queues the command when the connection is in a transaction, returning QUEUED and true, unless the command
+// is one that ends or starts a transaction, which executes straight away.
+func (s *Session) Queue(cmd Command) (protocol.Data, bool) {
+	if s.transaction == nil {
+		return nil, false
+	}
+
+	switch cmd.(type) {
+	case MultiCommand, ExecCommand, DiscardCommand:
+		return nil, false
+	default:
+		s.transaction.queued = append(s.transaction.queued, cmd)
+		return protocol.NewSimpleString("QUEUED"), true
+	}
+}
+
+// FailTransaction marks the transaction of the connection, if there is one, as failed because a request could not
+// be queued.
+func (s *Session) FailTransaction() {
+	if s.transaction != nil {
+		s.transaction.failed = true
+	}
+}
+
+// TransactionCommand executes the commands a connection queued between MULTI and EXEC as one unit, so that no
+// command of another connection runs between them.
+type TransactionCommand struct {
+	session *Session
+	queued  []Command
+}
+
+// Request is not logged, as the update requests of the transaction are logged together wrapped in MULTI and EXEC.
+func (cmd TransactionCommand) Request() ([]byte, Type) {
+	return nil, TypeUpdate
+}
+
+func (cmd TransactionCommand) Execute(_ store.Store) (protocol.Data, error) {
+	return nil, ErrorRequiresTransaction
+}
+
+// ExecuteTransaction executes the queued commands in order, returning their replies and writing their update
+// requests to the log, which is nil when the transaction is replayed from the log. Commands acting on the session,
+// such as SELECT, apply to the commands queued after them.
+func (cmd TransactionCommand) ExecuteTransaction(databases *store.Databases, log *store.CommandLog) (protocol.Data, error) {
+	var replies []protocol.Data
+
+	for _, queued := range cmd.queued {
+		if sessionCommand, ok := queued.(SessionCommand); ok {
+			data := sessionCommand.ExecuteInSession(cmd.session)
+			if several, ok := data.(protocol.Replies); ok {
+				// a command replying several times, such as SUBSCRIBE to many channels, gives an element for each
+				replies = append(replies, several.Data...)
+				continue
+			}
+			replies = append(replies, data)
+			continue
+		}
+
+		data, err := ExecuteInDatabase(queued, databases, cmd.session.Database)
+		if blockingCommand, ok := queued.(BlockingCommand); ok && errors.Is(err, ErrorBlocked) {
+			// a transaction never waits, so a blocking command replies as though its timeout had passed
+			data, err = blockingCommand.TimeoutResponse(), nil
+		}
+		if err != nil {
+			slog.Error("failed to execute queued request", "error", err)
+			replies = append(replies, protocol.NewSimpleError("ERR protocol error"))
+			continue
+		}
+
+		if request, commandType := queued.Request(); commandType == TypeUpdate && len(request) > 0 {
+			if err := log.Write(cmd.session.Database, request); err != nil {
+				return nil, err
+			}
+		}
+		replies = append(replies, data)
+	}
+
+	return protocol.NewArray(replies), nil
+}
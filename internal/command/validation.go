@@ -22,18 +22,141 @@ type validator struct {
 func NewValidator(clock store.Clock) Validator {
 	return &validator{
 		validators: map[string]commandValidator{
-			"PING":   PingValidator{},
-			"ECHO":   EchoValidator{},
-			"CONFIG": ConfigValidator{},
-			"DECR":   DecrValidator{},
-			"DEL":    DelValidator{},
-			"EXISTS": ExistsValidator{},
-			"INCR":   IncrValidator{},
-			"GET":    GetValidator{},
-			"LPUSH":  LPushValidator{},
-			"LRANGE": LRangeValidator{},
-			"RPUSH":  RPushValidator{},
-			"SET":    &SetValidator{clock: clock},
+			"PING":             PingValidator{},
+			"ECHO":             EchoValidator{},
+			"APPEND":           AppendValidator{},
+			"BLMOVE":           BLMoveValidator{clock: clock},
+			"BLMPOP":           BLMPopValidator{clock: clock},
+			"BLPOP":            BLPopValidator{clock: clock},
+			"BRPOP":            BLPopValidator{clock: clock, fromRight: true},
+			"CONFIG":           ConfigValidator{},
+			"COPY":             CopyValidator{},
+			"DBSIZE":           DBSizeValidator{},
+			"DECR":             DecrValidator{},
+			"DECRBY":           IncrByValidator{decrement: true},
+			"DEL":              DelValidator{},
+			"DISCARD":          DiscardValidator{},
+			"EXEC":             ExecValidator{},
+			"EXISTS":           ExistsValidator{},
+			"EXPIRE":           ExpireValidator{clock: clock, name: "expire"},
+			"EXPIREAT":         ExpireValidator{clock: clock, name: "expireat", absolute: true},
+			"EXPIRETIME":       TTLValidator{clock: clock, name: "expiretime", absolute: true},
+			"FLUSHALL":         FlushAllValidator{},
+			"FLUSHDB":          FlushDBValidator{},
+			"INCR":             IncrValidator{},
+			"GET":              GetValidator{},
+			"GETDEL":           GetDelValidator{},
+			"GETEX":            GetExValidator{clock: clock},
+			"GETRANGE":         GetRangeValidator{},
+			"GETSET":           GetSetValidator{},
+			"HELLO":            HelloValidator{},
+			"HDEL":             HDelValidator{},
+			"HEXISTS":          HExistsValidator{},
+			"HGET":             HGetValidator{},
+			"HGETALL":          HGetAllValidator{},
+			"HINCRBY":          HIncrByValidator{},
+			"HINCRBYFLOAT":     HIncrByFloatValidator{},
+			"HKEYS":            HKeysValidator{},
+			"HLEN":             HLenValidator{},
+			"HMGET":            HMGetValidator{},
+			"HSCAN":            HScanValidator{},
+			"HSET":             HSetValidator{},
+			"HSETNX":           HSetNxValidator{},
+			"HVALS":            HValsValidator{},
+			"INCRBY":           IncrByValidator{},
+			"INCRBYFLOAT":      IncrByFloatValidator{},
+			"KEYS":             KeysValidator{},
+			"LCS":              LcsValidator{},
+			"LINDEX":           LIndexValidator{},
+			"LINSERT":          LInsertValidator{},
+			"LLEN":             LLenValidator{},
+			"LMOVE":            LMoveValidator{},
+			"LMPOP":            LMPopValidator{},
+			"LPOP":             LPopValidator{},
+			"LPOS":             LPosValidator{},
+			"LPUSH":            LPushValidator{},
+			"LRANGE":           LRangeValidator{},
+			"LREM":             LRemValidator{},
+			"LSCAN":            LScanValidator{},
+			"LSET":             LSetValidator{},
+			"LTRIM":            LTrimValidator{},
+			"MGET":             MGetValidator{},
+			"MOVE":             MoveValidator{},
+			"MSET":             MSetValidator{},
+			"MSETNX":           MSetValidator{onlyIfAllMissing: true},
+			"MULTI":            MultiValidator{},
+			"PERSIST":          PersistValidator{},
+			"PEXPIRE":          ExpireValidator{clock: clock, name: "pexpire", inMilliseconds: true},
+			"PEXPIREAT":        ExpireValidator{clock: clock, name: "pexpireat", inMilliseconds: true, absolute: true},
+			"PEXPIRETIME":      TTLValidator{clock: clock, name: "pexpiretime", inMilliseconds: true, absolute: true},
+			"PSETEX":           SetExValidator{clock: clock, inMilliseconds: true},
+			"PSUBSCRIBE":       PSubscribeValidator{},
+			"PTTL":             TTLValidator{clock: clock, name: "pttl", inMilliseconds: true},
+			"PUBLISH":          PublishValidator{},
+			"PUBSUB":           PubSubValidator{},
+			"PUNSUBSCRIBE":     PUnsubscribeValidator{},
+			"RANDOMKEY":        RandomKeyValidator{},
+			"RENAME":           RenameValidator{},
+			"RENAMENX":         RenameValidator{onlyIfMissing: true},
+			"RPOP":             LPopValidator{fromRight: true},
+			"RPOPLPUSH":        RPopLPushValidator{},
+			"RPUSH":            RPushValidator{},
+			"SADD":             SAddValidator{},
+			"SCAN":             ScanValidator{},
+			"SCARD":            SCardValidator{},
+			"SDIFF":            SDiffValidator{},
+			"SDIFFSTORE":       SDiffStoreValidator{},
+			"SELECT":           SelectValidator{},
+			"SET":              &SetValidator{clock: clock},
+			"SETEX":            SetExValidator{clock: clock},
+			"SETNX":            SetNxValidator{},
+			"SETRANGE":         SetRangeValidator{},
+			"SINTER":           SInterValidator{},
+			"SINTERCARD":       SInterCardValidator{},
+			"SINTERSTORE":      SInterStoreValidator{},
+			"SISMEMBER":        SIsMemberValidator{},
+			"SMEMBERS":         SMembersValidator{},
+			"SMISMEMBER":       SMIsMemberValidator{},
+			"SMOVE":            SMoveValidator{},
+			"SPOP":             SPopValidator{},
+			"SPUBLISH":         SPublishValidator{},
+			"SRANDMEMBER":      SRandMemberValidator{},
+			"SREM":             SRemValidator{},
+			"SSCAN":            SScanValidator{},
+			"SSUBSCRIBE":       SSubscribeValidator{},
+			"STRLEN":           StrLenValidator{},
+			"SUBSCRIBE":        SubscribeValidator{},
+			"SUNION":           SUnionValidator{},
+			"SUNIONSTORE":      SUnionStoreValidator{},
+			"SUNSUBSCRIBE":     SUnsubscribeValidator{},
+			"SWAPDB":           SwapDBValidator{},
+			"TOUCH":            TouchValidator{},
+			"TTL":              TTLValidator{clock: clock, name: "ttl"},
+			"TYPE":             TypeValidator{},
+			"UNLINK":           UnlinkValidator{},
+			"UNSUBSCRIBE":      UnsubscribeValidator{},
+			"ZADD":             ZAddValidator{},
+			"ZCARD":            ZCardValidator{},
+			"ZCOUNT":           ZCountValidator{},
+			"ZDIFFSTORE":       ZDiffStoreValidator{},
+			"ZINCRBY":          ZIncrByValidator{},
+			"ZINTERSTORE":      ZInterStoreValidator{},
+			"ZLEXCOUNT":        ZLexCountValidator{},
+			"ZMPOP":            ZMPopValidator{},
+			"ZPOPMAX":          ZPopMinValidator{fromMaximum: true},
+			"ZPOPMIN":          ZPopMinValidator{},
+			"ZRANDMEMBER":      ZRandMemberValidator{},
+			"ZRANGE":           ZRangeValidator{},
+			"ZRANGESTORE":      ZRangeStoreValidator{},
+			"ZRANK":            ZRankValidator{},
+			"ZREM":             ZRemValidator{},
+			"ZREMRANGEBYLEX":   ZRemRangeByLexValidator{},
+			"ZREMRANGEBYRANK":  ZRemRangeByRankValidator{},
+			"ZREMRANGEBYSCORE": ZRemRangeByScoreValidator{},
+			"ZREVRANK":         ZRankValidator{reverse: true},
+			"ZSCAN":            ZScanValidator{},
+			"ZSCORE":           ZScoreValidator{},
+			"ZUNIONSTORE":      ZUnionStoreValidator{},
 		},
 		clock: clock,
 	}
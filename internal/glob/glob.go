@@ -0,0 +1,101 @@
+package glob
+
+import "strings"
+
+// maximumNesting limits how deeply * wildcards are matched, so patterns with many of them cannot exhaust the stack.
+const maximumNesting = 1000
+
+// Match reports whether the text matches the glob-style pattern, using the syntax of Redis where * matches any
+// sequence of bytes, ? matches any byte, [abc] and [a-z] match a set or range of bytes, [^abc] matches bytes not in
+// the set, and \ escapes the byte that follows it.
+func Match(pattern, text string) bool {
+	skipLongerMatches := false
+	return match(pattern, text, &skipLongerMatches, 0)
+}
+
+// match matches the pattern against the text, where skipLongerMatches is set once a * has failed to match any
+// remainder of the text, as a * earlier in the pattern cannot then match by consuming more of the text.
+func match(pattern, text string, skipLongerMatches *bool, nesting int) bool {
+	if nesting > maximumNesting {
+		return false
+	}
+
+	for len(pattern) > 0 && len(text) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for len(text) > 0 {
+				if match(pattern[1:], text, skipLongerMatches, nesting+1) {
+					return true
+				}
+				if *skipLongerMatches {
+					return false
+				}
+				text = text[1:]
+			}
+			*skipLongerMatches = true
+			return false
+		case '?':
+			pattern, text = pattern[1:], text[1:]
+		case '[':
+			var matched bool
+			if pattern, matched = matchSet(pattern[1:], text[0]); !matched {
+				return false
+			}
+			text = text[1:]
+		case '\\':
+			if len(pattern) >= 2 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if pattern[0] != text[0] {
+				return false
+			}
+			pattern, text = pattern[1:], text[1:]
+		}
+	}
+
+	if len(text) == 0 {
+		pattern = strings.TrimLeft(pattern, "*")
+	}
+	return len(pattern) == 0 && len(text) == 0
+}
+
+// matchSet matches the byte against the set that follows a [, returning the pattern after the set. A set without a
+// closing ] extends to the end of the pattern.
+func matchSet(pattern string, c byte) (string, bool) {
+	negated := len(pattern) > 0 && pattern[0] == '^'
+	if negated {
+		pattern = pattern[1:]
+	}
+
+	matched := false
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) >= 2:
+			pattern = pattern[1:]
+			matched = matched || pattern[0] == c
+		case len(pattern) >= 3 && pattern[1] == '-':
+			start, end := pattern[0], pattern[2]
+			if start > end {
+				start, end = end, start
+			}
+			matched = matched || (c >= start && c <= end)
+			pattern = pattern[2:]
+		default:
+			matched = matched || pattern[0] == c
+		}
+		pattern = pattern[1:]
+	}
+
+	if len(pattern) > 0 {
+		pattern = pattern[1:]
+	}
+	return pattern, matched != negated
+}
@@ -0,0 +1,87 @@
+package glob_test
+
+import (
+	"redis-challenge/internal/glob"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+
+	testCases := map[string]struct {
+		pattern  string
+		matching []string
+		other    []string
+	}{
+		"text without wildcards matches only itself": {
+			pattern:  "hello",
+			matching: []string{"hello"},
+			other:    []string{"hell", "hello!", "Hello"},
+		},
+		"star matches any sequence": {
+			pattern:  "h*llo*",
+			matching: []string{"hllo", "hello", "heeeello", "hello world"},
+			other:    []string{"hall", "ello"},
+		},
+		"question mark matches any single byte": {
+			pattern:  "h?llo",
+			matching: []string{"hello", "hallo", "hxllo"},
+			other:    []string{"hllo", "heello"},
+		},
+		"set matches any byte in it": {
+			pattern:  "h[ae]llo",
+			matching: []string{"hello", "hallo"},
+			other:    []string{"hillo", "hllo"},
+		},
+		"negated set matches any byte not in it": {
+			pattern:  "h[^e]llo",
+			matching: []string{"hallo", "hbllo"},
+			other:    []string{"hello", "hllo"},
+		},
+		"range matches bytes between its ends in either order": {
+			pattern:  "h[b-a]ll[0-9]",
+			matching: []string{"hall0", "hbll9"},
+			other:    []string{"hcll0", "hallo"},
+		},
+		"escape matches a special byte": {
+			pattern:  `h\*llo\?`,
+			matching: []string{"h*llo?"},
+			other:    []string{"hello?", "h*llo!"},
+		},
+		"escape in a set matches a special byte": {
+			pattern:  `h[\]\-]llo`,
+			matching: []string{"h]llo", "h-llo"},
+			other:    []string{`h\llo`},
+		},
+		"set without closing bracket extends to the end": {
+			pattern:  "h[ae",
+			matching: []string{"ha", "he"},
+			other:    []string{"h[ae", "hb"},
+		},
+		"star matches an empty remainder": {
+			pattern:  "key*",
+			matching: []string{"key", "key:1"},
+			other:    []string{"ke"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, text := range testCase.matching {
+				assert.True(t, glob.Match(testCase.pattern, text), "%q should match %q", testCase.pattern, text)
+			}
+			for _, text := range testCase.other {
+				assert.False(t, glob.Match(testCase.pattern, text), "%q should not match %q", testCase.pattern, text)
+			}
+		})
+	}
+
+	t.Run("many stars against text that does not match finishes quickly", func(t *testing.T) {
+		pattern := strings.Repeat("a*", 100) + "b"
+		text := strings.Repeat("a", 1000)
+
+		assert.False(t, glob.Match(pattern, text))
+	})
+}
@@ -0,0 +1,118 @@
+package hash
+
+import (
+	"iter"
+	"redis-challenge/internal/scan"
+)
+
+type Entry struct {
+	Field string
+	Value string
+}
+
+// Hash is a map of fields to values that keeps the fields in the order they were first added, until a field is deleted
+// and the last field takes its place.
+type Hash struct {
+	entries   []Entry
+	indexes   map[string]int
+	scanOrder scan.Order
+}
+
+func New() *Hash {
+	return &Hash{indexes: make(map[string]int)}
+}
+
+func (h *Hash) Len() int {
+	if h == nil {
+		return 0
+	}
+	return len(h.entries)
+}
+
+func (h *Hash) Get(field string) (string, bool) {
+	if h == nil {
+		return "", false
+	}
+	if index, ok := h.indexes[field]; ok {
+		return h.entries[index].Value, true
+	}
+	return "", false
+}
+
+// Set sets the value of the field and returns true if the field was added to the hash.
+func (h *Hash) Set(field string, value string) bool {
+	if index, ok := h.indexes[field]; ok {
+		h.entries[index].Value = value
+		return false
+	}
+
+	h.indexes[field] = len(h.entries)
+	h.entries = append(h.entries, Entry{Field: field, Value: value})
+	h.scanOrder.Reset()
+	return true
+}
+
+// Delete removes the field and returns true if the field was in the hash. The last field is moved into the place of
+// the deleted field, so deleting takes the same time however large the hash is.
+func (h *Hash) Delete(field string) bool {
+	index, ok := h.indexes[field]
+	if !ok {
+		return false
+	}
+
+	delete(h.indexes, field)
+	last := len(h.entries) - 1
+	if index != last {
+		h.entries[index] = h.entries[last]
+		h.indexes[h.entries[index].Field] = index
+	}
+	h.entries[last] = Entry{}
+	h.entries = h.entries[:last]
+	h.scanOrder.Reset()
+	return true
+}
+
+// Scan returns at least count fields from the cursor onwards with the cursor to continue from, in the order of
+// their scan position.
+func (h *Hash) Scan(cursor uint64, count int) (uint64, []string) {
+	if h == nil {
+		return 0, nil
+	}
+	return h.scanOrder.Page(h.fields(), cursor, count)
+}
+
+// fields returns the fields of the hash without their values.
+func (h *Hash) fields() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, e := range h.entries {
+			if !yield(e.Field) {
+				return
+			}
+		}
+	}
+}
+
+func (h *Hash) Entries() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		if h == nil {
+			return
+		}
+		for _, e := range h.entries {
+			if !yield(e.Field, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (h *Hash) Copy() *Hash {
+	copied := &Hash{
+		entries: make([]Entry, len(h.entries)),
+		indexes: make(map[string]int, len(h.indexes)),
+	}
+	copy(copied.entries, h.entries)
+	for field, index := range h.indexes {
+		copied.indexes[field] = index
+	}
+	return copied
+}
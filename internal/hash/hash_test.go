@@ -0,0 +1,107 @@
+package hash_test
+
+import (
+	"redis-challenge/internal/hash"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+
+	entries := func(h *hash.Hash) []hash.Entry {
+		var result []hash.Entry
+		for field, value := range h.Entries() {
+			result = append(result, hash.Entry{Field: field, Value: value})
+		}
+		return result
+	}
+
+	t.Run("setting new fields adds them in order", func(t *testing.T) {
+		h := hash.New()
+
+		assert.True(t, h.Set("b", "1"))
+		assert.True(t, h.Set("a", "2"))
+
+		assert.Equal(t, 2, h.Len())
+		assert.Equal(t, []hash.Entry{{Field: "b", Value: "1"}, {Field: "a", Value: "2"}}, entries(h))
+	})
+
+	t.Run("setting an existing field updates the value in place", func(t *testing.T) {
+		h := hash.New()
+		h.Set("b", "1")
+		h.Set("a", "2")
+
+		assert.False(t, h.Set("b", "3"))
+
+		value, ok := h.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, "3", value)
+		assert.Equal(t, []hash.Entry{{Field: "b", Value: "3"}, {Field: "a", Value: "2"}}, entries(h))
+	})
+
+	t.Run("getting a missing field is not ok", func(t *testing.T) {
+		h := hash.New()
+
+		_, ok := h.Get("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("deleting a field moves the last field into its place", func(t *testing.T) {
+		h := hash.New()
+		h.Set("a", "1")
+		h.Set("b", "2")
+		h.Set("c", "3")
+
+		assert.True(t, h.Delete("a"))
+		assert.False(t, h.Delete("a"))
+
+		assert.Equal(t, []hash.Entry{{Field: "c", Value: "3"}, {Field: "b", Value: "2"}}, entries(h))
+
+		value, ok := h.Get("c")
+		assert.True(t, ok)
+		assert.Equal(t, "3", value)
+	})
+
+	t.Run("deleting the last field leaves the order of the other fields", func(t *testing.T) {
+		h := hash.New()
+		h.Set("a", "1")
+		h.Set("b", "2")
+
+		assert.True(t, h.Delete("b"))
+		assert.True(t, h.Set("c", "3"))
+
+		assert.Equal(t, []hash.Entry{{Field: "a", Value: "1"}, {Field: "c", Value: "3"}}, entries(h))
+	})
+
+	t.Run("every field can be deleted", func(t *testing.T) {
+		h := hash.New()
+		h.Set("a", "1")
+		h.Set("b", "2")
+
+		assert.True(t, h.Delete("a"))
+		assert.True(t, h.Delete("b"))
+
+		assert.Equal(t, 0, h.Len())
+		_, ok := h.Get("b")
+		assert.False(t, ok)
+	})
+
+	t.Run("a copy is not changed by updates to the original", func(t *testing.T) {
+		h := hash.New()
+		h.Set("a", "1")
+
+		copied := h.Copy()
+		h.Set("a", "2")
+		h.Set("b", "3")
+
+		assert.Equal(t, []hash.Entry{{Field: "a", Value: "1"}}, entries(copied))
+	})
+
+	t.Run("a nil hash is empty", func(t *testing.T) {
+		var h *hash.Hash
+
+		assert.Equal(t, 0, h.Len())
+		assert.Empty(t, entries(h))
+	})
+}
@@ -1,6 +1,9 @@
 package list
 
-import "iter"
+import (
+	"iter"
+	"slices"
+)
 
 type DoubleEndedList struct {
 	left  []string
@@ -27,12 +30,12 @@ func (l DoubleEndedList) Filter(start, end int) DoubleEndedList {
 		to = length + end + 1
 	}
 
-	if length == 0 || to <= from {
-		return DoubleEndedList{}
-	}
 	if to > length {
 		to = length
 	}
+	if length == 0 || to <= from {
+		return DoubleEndedList{}
+	}
 
 	middleIndex := len(l.left)
 
@@ -74,6 +77,195 @@ func (l DoubleEndedList) ToList() []string {
 	return result
 }
 
+// Copy returns a list with the same values that does not share storage with this list, so pushing to either list
+// does not change the other.
+func (l DoubleEndedList) Copy() DoubleEndedList {
+	return DoubleEndedList{left: slices.Clone(l.left), right: slices.Clone(l.right)}
+}
+
+// Index returns the value at the index, where a negative index counts back from the end of the list.
+func (l DoubleEndedList) Index(index int) (string, bool) {
+	index, ok := l.position(index)
+	if !ok {
+		return "", false
+	}
+
+	if index < len(l.left) {
+		return l.left[len(l.left)-1-index], true
+	}
+	return l.right[index-len(l.left)], true
+}
+
+// Set returns the list with the value at the index replaced in place, where a negative index counts back from the
+// end of the list. Ranges are copied when they are read, so none share the storage of the list.
+func (l DoubleEndedList) Set(index int, value string) (DoubleEndedList, bool) {
+	index, ok := l.position(index)
+	if !ok {
+		return l, false
+	}
+
+	if index < len(l.left) {
+		l.left[len(l.left)-1-index] = value
+	} else {
+		l.right[index-len(l.left)] = value
+	}
+	return l, true
+}
+
+// Insert returns the list with the value inserted before or after the first occurrence of the pivot, or false if
+// the pivot is not in the list.
+func (l DoubleEndedList) Insert(pivot string, value string, before bool) (DoubleEndedList, bool) {
+	index := l.IndexOf(pivot)
+	if index < 0 {
+		return l, false
+	}
+	if !before {
+		index++
+	}
+
+	// The left values are stored in reverse, so inserting before an index is inserting after its position
+	if index <= len(l.left) {
+		at := len(l.left) - index
+		l.left = slices.Concat(l.left[:at], []string{value}, l.left[at:])
+	} else {
+		at := index - len(l.left)
+		l.right = slices.Concat(l.right[:at], []string{value}, l.right[at:])
+	}
+	return l, true
+}
+
+// IndexOf returns the index of the first occurrence of the value, or -1 if the value is not in the list.
+func (l DoubleEndedList) IndexOf(value string) int {
+	for i, x := range l.Range() {
+		if x == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Positions returns the indexes of the matches of the value, starting from the match at the rank where a negative
+// rank counts matches back from the end of the list. A count of zero returns every match and a maxLength of zero
+// compares every value.
+func (l DoubleEndedList) Positions(value string, rank int, count int, maxLength int) []int {
+	length := l.Len()
+	if maxLength == 0 || maxLength > length {
+		maxLength = length
+	}
+
+	step, index := 1, 0
+	if rank < 0 {
+		step, index, rank = -1, length-1, -rank
+	}
+
+	positions := make([]int, 0)
+	for compared := 0; compared < maxLength && (count == 0 || len(positions) < count); compared++ {
+		if x, _ := l.Index(index); x == value {
+			if rank > 1 {
+				rank--
+			} else {
+				positions = append(positions, index)
+			}
+		}
+		index += step
+	}
+	return positions
+}
+
+// Remove returns the list without count occurrences of the value, counting from the start of the list or from the
+// end if the count is negative, or without every occurrence if the count is zero.
+func (l DoubleEndedList) Remove(value string, count int) (DoubleEndedList, int) {
+	values := l.ToList()
+	if count < 0 {
+		slices.Reverse(values)
+	}
+
+	var removed int
+	remaining := make([]string, 0, len(values))
+	for _, x := range values {
+		if x == value && (count == 0 || removed < abs(count)) {
+			removed++
+			continue
+		}
+		remaining = append(remaining, x)
+	}
+
+	if count < 0 {
+		slices.Reverse(remaining)
+	}
+	return balanced(remaining), removed
+}
+
+// Trim returns the list with only the values from the start to the end index inclusive. The trimmed values are
+// clipped so that pushing to the list cannot overwrite ranges already read from the list.
+func (l DoubleEndedList) Trim(start, end int) DoubleEndedList {
+	trimmed := l.Filter(start, end)
+	return DoubleEndedList{left: slices.Clip(trimmed.left), right: slices.Clip(trimmed.right)}
+}
+
+// LeftPop returns the list without up to count values from the start, along with the values which were removed. The
+// popped slots are cleared and kept, so pushing to the list again does not copy its values.
+func (l DoubleEndedList) LeftPop(count int) (DoubleEndedList, []string) {
+	popped := make([]string, 0, min(count, l.Len()))
+	for len(popped) < cap(popped) {
+		if len(l.left) == 0 {
+			// Move the first half of the values to the left, rounding up so that a single value can be popped
+			l = split(l.right, (len(l.right)+1)/2)
+		}
+
+		last := len(l.left) - 1
+		popped = append(popped, l.left[last])
+		l.left[last] = ""
+		l.left = l.left[:last]
+	}
+	return l, popped
+}
+
+// RightPop returns the list without up to count values from the end, along with the values which were removed. The
+// popped slots are cleared and kept, so pushing to the list again does not copy its values.
+func (l DoubleEndedList) RightPop(count int) (DoubleEndedList, []string) {
+	popped := make([]string, 0, min(count, l.Len()))
+	for len(popped) < cap(popped) {
+		if len(l.right) == 0 {
+			l = split(l.ToList(), len(l.left)/2)
+		}
+
+		last := len(l.right) - 1
+		popped = append(popped, l.right[last])
+		l.right[last] = ""
+		l.right = l.right[:last]
+	}
+	return l, popped
+}
+
+// position returns the index counted from the start of the list, or false if the index is out of range.
+func (l DoubleEndedList) position(index int) (int, bool) {
+	if index < 0 {
+		index += l.Len()
+	}
+	return index, index >= 0 && index < l.Len()
+}
+
+// balanced returns a list of the values with half stored on the left and half on the right, so that popping from
+// either end does not need to move values between sides.
+func balanced(values []string) DoubleEndedList {
+	return split(values, len(values)/2)
+}
+
+// split returns a list of the values with those before the middle stored on the left and the rest on the right.
+func split(values []string, middle int) DoubleEndedList {
+	left := slices.Clone(values[:middle])
+	slices.Reverse(left)
+	return DoubleEndedList{left: left, right: slices.Clone(values[middle:])}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
 func ReadRangeFromStoreList(storedData any, start, end int) (DoubleEndedList, bool) {
 	storedList, ok := parseListFromStoredData(storedData)
 	if !ok {
@@ -0,0 +1,137 @@
+package list_test
+
+import (
+	"redis-challenge/internal/list"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditList(t *testing.T) {
+
+	pushed := func() list.DoubleEndedList {
+		pushedList, _ := list.LeftPush([]string{"b", "a"}, nil)
+		pushedList, _ = list.RightPush([]string{"c", "a", "d"}, pushedList)
+		return pushedList
+	}
+
+	t.Run("index counts from the start or back from the end", func(t *testing.T) {
+		value, ok := pushed().Index(1)
+		assert.True(t, ok)
+		assert.Equal(t, "b", value)
+
+		value, ok = pushed().Index(-3)
+		assert.True(t, ok)
+		assert.Equal(t, "c", value)
+
+		_, ok = pushed().Index(5)
+		assert.False(t, ok)
+	})
+
+	t.Run("set replaces the value on either side without changing a copy", func(t *testing.T) {
+		original := pushed()
+		copied := original.Copy()
+
+		updated, ok := original.Set(0, "x")
+		assert.True(t, ok)
+		updated, ok = updated.Set(-1, "y")
+		assert.True(t, ok)
+
+		confirmListFilterRange(t, []string{"x", "b", "c", "a", "y"}, updated)
+		confirmListFilterRange(t, []string{"a", "b", "c", "a", "d"}, copied)
+	})
+
+	t.Run("set out of range is not ok", func(t *testing.T) {
+		_, ok := pushed().Set(-6, "x")
+
+		assert.False(t, ok)
+	})
+
+	testCases := map[string]struct {
+		pivot    string
+		before   bool
+		expected []string
+	}{
+		"insert before the first value":           {pivot: "a", before: true, expected: []string{"x", "a", "b", "c", "a", "d"}},
+		"insert after a value on the left":        {pivot: "b", before: false, expected: []string{"a", "b", "x", "c", "a", "d"}},
+		"insert before a value on the right":      {pivot: "c", before: true, expected: []string{"a", "b", "x", "c", "a", "d"}},
+		"insert after the last value":             {pivot: "d", before: false, expected: []string{"a", "b", "c", "a", "d", "x"}},
+		"insert after the first match of a pivot": {pivot: "a", before: false, expected: []string{"a", "x", "b", "c", "a", "d"}},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			updated, ok := pushed().Insert(testCase.pivot, "x", testCase.before)
+
+			assert.True(t, ok)
+			confirmListFilterRange(t, testCase.expected, updated)
+		})
+	}
+
+	t.Run("insert with a missing pivot is not ok", func(t *testing.T) {
+		_, ok := pushed().Insert("z", "x", true)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("remove with a positive count removes from the start", func(t *testing.T) {
+		pushedList, _ := list.RightPush([]string{"a", "b", "a", "c", "a"}, nil)
+
+		updated, removed := pushedList.Remove("a", 2)
+
+		assert.Equal(t, 2, removed)
+		confirmListFilterRange(t, []string{"b", "c", "a"}, updated)
+	})
+
+	t.Run("remove with a negative count removes from the end", func(t *testing.T) {
+		pushedList, _ := list.RightPush([]string{"a", "b", "a", "c", "a"}, nil)
+
+		updated, removed := pushedList.Remove("a", -2)
+
+		assert.Equal(t, 2, removed)
+		confirmListFilterRange(t, []string{"a", "b", "c"}, updated)
+	})
+
+	t.Run("remove with a zero count removes every match", func(t *testing.T) {
+		updated, removed := pushed().Remove("a", 0)
+
+		assert.Equal(t, 2, removed)
+		confirmListFilterRange(t, []string{"b", "c", "d"}, updated)
+	})
+
+	t.Run("trim keeps the values in the range", func(t *testing.T) {
+		trimmed := pushed().Trim(1, -2)
+
+		confirmListFilterRange(t, []string{"b", "c", "a"}, trimmed)
+	})
+
+	t.Run("pushing after a trim does not change the untrimmed list", func(t *testing.T) {
+		original := pushed()
+
+		_, _ = list.RightPush([]string{"x"}, original.Trim(0, 2))
+
+		confirmListFilterRange(t, []string{"a", "b", "c", "a", "d"}, original)
+	})
+
+	positionCases := map[string]struct {
+		rank      int
+		count     int
+		maxLength int
+		expected  []int
+	}{
+		"positions of the first match":                   {rank: 1, count: 1, expected: []int{0}},
+		"positions of every match":                       {rank: 1, count: 0, expected: []int{0, 3}},
+		"positions from the second match":                {rank: 2, count: 0, expected: []int{3}},
+		"positions from the end of the list":             {rank: -1, count: 0, expected: []int{3, 0}},
+		"positions with a maximum number of comparisons": {rank: 1, count: 0, maxLength: 3, expected: []int{0}},
+		"positions beyond the number of matches":         {rank: 3, count: 0, expected: []int{}},
+	}
+
+	for name, testCase := range positionCases {
+		t.Run(name, func(t *testing.T) {
+			positions := pushed().Positions("a", testCase.rank, testCase.count, testCase.maxLength)
+
+			assert.Equal(t, testCase.expected, positions)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package list_test
+
+import (
+	"redis-challenge/internal/list"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopFromList(t *testing.T) {
+
+	pushed := func() list.DoubleEndedList {
+		pushedList, _ := list.LeftPush([]string{"b", "a"}, nil)
+		pushedList, _ = list.RightPush([]string{"c", "d", "e"}, pushedList)
+		return pushedList
+	}
+
+	t.Run("left pop removes values from the start in order", func(t *testing.T) {
+		poppedList, popped := pushed().LeftPop(3)
+
+		assert.Equal(t, []string{"a", "b", "c"}, popped)
+		confirmListFilterRange(t, []string{"d", "e"}, poppedList)
+	})
+
+	t.Run("right pop removes values from the end in order", func(t *testing.T) {
+		poppedList, popped := pushed().RightPop(4)
+
+		assert.Equal(t, []string{"e", "d", "c", "b"}, popped)
+		confirmListFilterRange(t, []string{"a"}, poppedList)
+	})
+
+	t.Run("right pop from a left-pushed list takes the first pushed value", func(t *testing.T) {
+		pushedList, _ := list.LeftPush([]string{"a", "b", "c"}, nil)
+
+		poppedList, popped := pushedList.RightPop(1)
+
+		assert.Equal(t, []string{"a"}, popped)
+		confirmListFilterRange(t, []string{"c", "b"}, poppedList)
+	})
+
+	t.Run("left pop from a right-pushed list with a single value takes the value", func(t *testing.T) {
+		pushedList, _ := list.RightPush([]string{"a"}, nil)
+
+		poppedList, popped := pushedList.LeftPop(1)
+
+		assert.Equal(t, []string{"a"}, popped)
+		assert.Equal(t, 0, poppedList.Len())
+	})
+
+	t.Run("pop with a count beyond the length removes every value", func(t *testing.T) {
+		poppedList, popped := pushed().LeftPop(10)
+
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, popped)
+		assert.Equal(t, 0, poppedList.Len())
+	})
+
+	t.Run("alternating pops and pushes keep the order", func(t *testing.T) {
+		pushedList, _ := list.RightPush([]string{"a", "b", "c", "d"}, nil)
+
+		pushedList, popped := pushedList.LeftPop(1)
+		assert.Equal(t, []string{"a"}, popped)
+		pushedList, _ = list.LeftPush([]string{"x"}, pushedList)
+		pushedList, popped = pushedList.RightPop(2)
+		assert.Equal(t, []string{"d", "c"}, popped)
+		pushedList, _ = list.RightPush([]string{"y"}, pushedList)
+
+		confirmListFilterRange(t, []string{"x", "b", "y"}, pushedList)
+	})
+
+	t.Run("pushing after a pop does not change a copy taken before the pop", func(t *testing.T) {
+		pushedList := pushed()
+		copied := pushedList.Filter(0, -1).Copy()
+
+		pushedList, _ = pushedList.RightPop(1)
+		pushedList, _ = pushedList.LeftPop(1)
+		_, _ = list.RightPush([]string{"z"}, pushedList)
+
+		confirmListFilterRange(t, []string{"a", "b", "c", "d", "e"}, copied)
+	})
+
+	t.Run("pushing after a pop fills the popped slot", func(t *testing.T) {
+		pushedList := pushed()
+
+		pushedList, _ = pushedList.RightPop(2)
+		pushedList, _ = list.RightPush([]string{"y", "z"}, pushedList)
+
+		confirmListFilterRange(t, []string{"a", "b", "c", "y", "z"}, pushedList)
+	})
+}
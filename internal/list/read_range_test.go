@@ -83,6 +83,12 @@ func TestReadRangeFromStoreList(t *testing.T) {
 			end:        -2,
 			expected:   []string{"b", "c", "d"},
 		},
+		"range from right-pushed list with start beyond the end of the list returns nil": {
+			storedList: []string{"a", "b"},
+			start:      5,
+			end:        10,
+			expected:   nil,
+		},
 		"range from right-pushed list with start positive and end negative such that end is before start": {
 			storedList: []string{"a", "b", "c", "d", "e"},
 			start:      4,
@@ -58,6 +58,17 @@ func (s Array) Symbol() DataTypeSymbol {
 	return ArraySymbol
 }
 
+// NullArray is the absence of an array, which some commands reply with instead of a null bulk string.
+type NullArray struct{}
+
+func NewNullArray() NullArray {
+	return NullArray{}
+}
+
+func (s NullArray) Symbol() DataTypeSymbol {
+	return ArraySymbol
+}
+
 type DoubleEndedList struct {
 	Data list.DoubleEndedList
 }
@@ -69,3 +80,121 @@ func (s DoubleEndedList) Symbol() DataTypeSymbol {
 func NewDoubleEndedList(list list.DoubleEndedList) Data {
 	return DoubleEndedList{Data: list}
 }
+
+type MapEntry struct {
+	Key   Data
+	Value Data
+}
+
+type Map struct {
+	Entries []MapEntry
+}
+
+func NewMap(entries []MapEntry) Map {
+	return Map{Entries: entries}
+}
+
+func (s Map) Symbol() DataTypeSymbol {
+	return MapSymbol
+}
+
+// Pairs is an array of pairs, which is a flattened array of keys and values for RESP2 and an array of 2-element
+// arrays for RESP3.
+type Pairs struct {
+	Entries []MapEntry
+}
+
+func NewPairs(entries []MapEntry) Pairs {
+	return Pairs{Entries: entries}
+}
+
+func (s Pairs) Symbol() DataTypeSymbol {
+	return ArraySymbol
+}
+
+type Set struct {
+	Data []Data
+}
+
+func NewSet(data []Data) Set {
+	return Set{Data: data}
+}
+
+func (s Set) Symbol() DataTypeSymbol {
+	return SetSymbol
+}
+
+type Double float64
+
+func NewDouble(value float64) Double {
+	return Double(value)
+}
+
+func (s Double) Symbol() DataTypeSymbol {
+	return DoubleSymbol
+}
+
+type Boolean bool
+
+func NewBoolean(value bool) Boolean {
+	return Boolean(value)
+}
+
+func (s Boolean) Symbol() DataTypeSymbol {
+	return BooleanSymbol
+}
+
+type BigNumber string
+
+func NewBigNumber(digits string) BigNumber {
+	return BigNumber(digits)
+}
+
+func (s BigNumber) Symbol() DataTypeSymbol {
+	return BigNumberSymbol
+}
+
+type VerbatimString struct {
+	Format string
+	Text   string
+}
+
+func NewVerbatimString(format string, text string) VerbatimString {
+	return VerbatimString{Format: format, Text: text}
+}
+
+func (s VerbatimString) Symbol() DataTypeSymbol {
+	return VerbatimStringSymbol
+}
+
+// Push is data the server sends without it being requested, such as a message published to a subscribed channel,
+// which is an array for RESP2.
+type Push struct {
+	Data []Data
+}
+
+func NewPush(data []Data) Push {
+	return Push{Data: data}
+}
+
+func (s Push) Symbol() DataTypeSymbol {
+	return PushSymbol
+}
+
+// Replies are several replies to a single request written one after another, such as SUBSCRIBE confirming each
+// channel it subscribes to.
+type Replies struct {
+	Data []Data
+}
+
+func NewReplies(data []Data) Replies {
+	return Replies{Data: data}
+}
+
+// Symbol returns the symbol of the first reply.
+func (s Replies) Symbol() DataTypeSymbol {
+	if len(s.Data) == 0 {
+		return NullSymbol
+	}
+	return s.Data[0].Symbol()
+}
@@ -32,6 +32,30 @@ func TestDataTypeHasResp_Symbol(t *testing.T) {
 			data:   protocol.NewArray(nil),
 			symbol: '*',
 		},
+		"map": {
+			data:   protocol.NewMap(nil),
+			symbol: '%',
+		},
+		"set": {
+			data:   protocol.NewSet(nil),
+			symbol: '~',
+		},
+		"double": {
+			data:   protocol.NewDouble(1.5),
+			symbol: ',',
+		},
+		"boolean": {
+			data:   protocol.NewBoolean(true),
+			symbol: '#',
+		},
+		"big number": {
+			data:   protocol.NewBigNumber("12345678901234567890"),
+			symbol: '(',
+		},
+		"verbatim string": {
+			data:   protocol.NewVerbatimString("txt", "message"),
+			symbol: '=',
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FormatDouble formats a double in the same way as Redis, which uses the shortest digits that identify the value
+// and only switches to scientific notation for very large or very small exponents.
+func FormatDouble(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "nan"
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	case value == 0:
+		if math.Signbit(value) {
+			return "-0"
+		}
+		return "0"
+	}
+
+	negative := value < 0
+
+	scientific := strconv.FormatFloat(math.Abs(value), 'e', -1, 64)
+	mantissa, exponentText, _ := strings.Cut(scientific, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exponent, _ := strconv.Atoi(exponentText)
+
+	text := formatDigits(digits, exponent-len(digits)+1, negative)
+	if negative {
+		return "-" + text
+	}
+	return text
+}
+
+// formatDigits writes the value digits * 10^k following the rules of the fpconv library used by Redis.
+func formatDigits(digits string, k int, negative bool) string {
+	digitCount := len(digits)
+	exponent := absolute(k + digitCount - 1)
+
+	if k >= 0 && exponent < digitCount+7 {
+		return digits + strings.Repeat("0", k)
+	}
+
+	if k < 0 && (k > -7 || exponent < 4) {
+		offset := digitCount - absolute(k)
+		if offset <= 0 {
+			return "0." + strings.Repeat("0", -offset) + digits
+		}
+		return digits[:offset] + "." + digits[offset:]
+	}
+
+	maximumDigitCount := 18
+	if negative {
+		maximumDigitCount--
+	}
+	digitCount = min(digitCount, maximumDigitCount)
+
+	var builder strings.Builder
+	builder.WriteByte(digits[0])
+	if digitCount > 1 {
+		builder.WriteByte('.')
+		builder.WriteString(digits[1:digitCount])
+	}
+
+	builder.WriteByte('e')
+	if k+digitCount-1 < 0 {
+		builder.WriteByte('-')
+	} else {
+		builder.WriteByte('+')
+	}
+	builder.WriteString(strconv.Itoa(exponent))
+
+	return builder.String()
+}
+
+func absolute(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
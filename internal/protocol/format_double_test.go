@@ -0,0 +1,36 @@
+package protocol_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"redis-challenge/internal/protocol"
+	"testing"
+)
+
+func TestFormatDouble(t *testing.T) {
+
+	testCases := map[string]struct {
+		value    float64
+		expected string
+	}{
+		"zero":                                  {value: 0, expected: "0"},
+		"integer":                               {value: 42, expected: "42"},
+		"negative integer":                      {value: -42, expected: "-42"},
+		"large integer is not scientific":       {value: 1234567, expected: "1234567"},
+		"very large integer is scientific":      {value: 1e20, expected: "1e+20"},
+		"fraction":                              {value: 1.5, expected: "1.5"},
+		"fraction below one":                    {value: 0.1, expected: "0.1"},
+		"shortest representation of a fraction": {value: 1.1, expected: "1.1"},
+		"small fraction":                        {value: 0.0001, expected: "0.0001"},
+		"very small fraction is scientific":     {value: 1.5e-7, expected: "1.5e-7"},
+		"very large fraction is scientific":     {value: 1.2345e100, expected: "1.2345e+100"},
+		"positive infinity":                     {value: math.Inf(1), expected: "inf"},
+		"negative infinity":                     {value: math.Inf(-1), expected: "-inf"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, protocol.FormatDouble(testCase.value))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package protocol
+
+import (
+	"bytes"
+	"strconv"
+)
+
+const maximumInlineRequestByteCount = 64 * 1024
+
+// ReadRequest reads a client request, which is either an array of bulk strings or an inline command
+// (as typed into telnet) of space-separated arguments terminated by a newline.
+func ReadRequest(bs []byte) (Data, int) {
+	if len(bs) == 0 {
+		return nil, 0
+	}
+	if DataTypeSymbol(bs[0]) == ArraySymbol {
+		return ReadFrame(bs)
+	}
+	return readInlineRequest(bs)
+}
+
+func readInlineRequest(bs []byte) (Data, int) {
+	newlineIndex := bytes.IndexByte(bs, '\n')
+	if newlineIndex == -1 {
+		if len(bs) > maximumInlineRequestByteCount {
+			return NewSimpleError("ERR Protocol error: too big inline request"), len(bs)
+		}
+		return nil, 0
+	}
+
+	line := bytes.TrimSuffix(bs[:newlineIndex], []byte("\r"))
+
+	arguments, ok := splitInlineArguments(line)
+	if !ok {
+		return NewSimpleError("ERR Protocol error: unbalanced quotes in request"), newlineIndex + 1
+	}
+
+	data := make([]Data, len(arguments))
+	for i, argument := range arguments {
+		data[i] = NewBulkString(argument)
+	}
+	return NewArray(data), newlineIndex + 1
+}
+
+// splitInlineArguments splits a line in the same way as Redis, where arguments are separated by whitespace
+// and may be double-quoted (supporting escapes such as \n and \x41) or single-quoted (supporting only \').
+func splitInlineArguments(line []byte) ([]string, bool) {
+	var arguments []string
+
+	i := 0
+	for {
+		for i < len(line) && isInlineSpace(line[i]) {
+			i++
+		}
+		if i == len(line) {
+			return arguments, true
+		}
+
+		var argument []byte
+		inDoubleQuotes := false
+		inSingleQuotes := false
+
+		for done := false; !done; {
+			switch {
+			case inDoubleQuotes:
+				switch {
+				case i == len(line):
+					return nil, false
+				case line[i] == '\\' && i+3 < len(line) && line[i+1] == 'x' && isHexDigit(line[i+2]) && isHexDigit(line[i+3]):
+					value, _ := strconv.ParseUint(string(line[i+2:i+4]), 16, 8)
+					argument = append(argument, byte(value))
+					i += 3
+				case line[i] == '\\' && i+1 < len(line):
+					i++
+					argument = append(argument, unescapeInlineCharacter(line[i]))
+				case line[i] == '"':
+					if i+1 < len(line) && !isInlineSpace(line[i+1]) {
+						return nil, false
+					}
+					done = true
+				default:
+					argument = append(argument, line[i])
+				}
+			case inSingleQuotes:
+				switch {
+				case i == len(line):
+					return nil, false
+				case line[i] == '\\' && i+1 < len(line) && line[i+1] == '\'':
+					i++
+					argument = append(argument, '\'')
+				case line[i] == '\'':
+					if i+1 < len(line) && !isInlineSpace(line[i+1]) {
+						return nil, false
+					}
+					done = true
+				default:
+					argument = append(argument, line[i])
+				}
+			default:
+				switch {
+				case i == len(line) || isInlineSpace(line[i]):
+					done = true
+				case line[i] == '"':
+					inDoubleQuotes = true
+				case line[i] == '\'':
+					inSingleQuotes = true
+				default:
+					argument = append(argument, line[i])
+				}
+			}
+
+			if i < len(line) {
+				i++
+			}
+		}
+
+		arguments = append(arguments, string(argument))
+	}
+}
+
+func isInlineSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func unescapeInlineCharacter(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'b':
+		return '\b'
+	case 'a':
+		return '\a'
+	default:
+		return c
+	}
+}
@@ -0,0 +1,115 @@
+package protocol_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/protocol"
+	"strings"
+	"testing"
+)
+
+func TestReadRequest(t *testing.T) {
+
+	bulkStrings := func(values ...string) protocol.Data {
+		data := make([]protocol.Data, len(values))
+		for i, value := range values {
+			data[i] = protocol.NewBulkString(value)
+		}
+		return protocol.NewArray(data)
+	}
+
+	tests := map[string]struct {
+		input         string
+		expectedData  protocol.Data
+		expectedBytes int
+	}{
+		"array request is read as a frame": {
+			input:         "*1\r\n$4\r\nPING\r\n",
+			expectedData:  bulkStrings("PING"),
+			expectedBytes: len("*1\r\n$4\r\nPING\r\n"),
+		},
+		"partial inline request": {
+			input:         "PIN",
+			expectedData:  nil,
+			expectedBytes: 0,
+		},
+		"inline request terminated by carriage return and newline": {
+			input:         "PING\r\n",
+			expectedData:  bulkStrings("PING"),
+			expectedBytes: len("PING\r\n"),
+		},
+		"inline request terminated by newline": {
+			input:         "PING\n",
+			expectedData:  bulkStrings("PING"),
+			expectedBytes: len("PING\n"),
+		},
+		"inline request followed by partial of next request": {
+			input:         "PING\r\nECH",
+			expectedData:  bulkStrings("PING"),
+			expectedBytes: len("PING\r\n"),
+		},
+		"inline request with space-separated arguments": {
+			input:         "SET  foo\tbar \r\n",
+			expectedData:  bulkStrings("SET", "foo", "bar"),
+			expectedBytes: len("SET  foo\tbar \r\n"),
+		},
+		"blank inline request has no arguments": {
+			input:         "  \r\n",
+			expectedData:  bulkStrings(),
+			expectedBytes: len("  \r\n"),
+		},
+		"inline request with a double-quoted argument": {
+			input:         "ECHO \"hello world\"\r\n",
+			expectedData:  bulkStrings("ECHO", "hello world"),
+			expectedBytes: len("ECHO \"hello world\"\r\n"),
+		},
+		"inline request with escapes in a double-quoted argument": {
+			input:         "ECHO \"a\\n\\t\\\"b\\\\\\x41\"\r\n",
+			expectedData:  bulkStrings("ECHO", "a\n\t\"b\\A"),
+			expectedBytes: len("ECHO \"a\\n\\t\\\"b\\\\\\x41\"\r\n"),
+		},
+		"inline request with a single-quoted argument keeps backslashes": {
+			input:         "ECHO 'it\\'s a \\n'\r\n",
+			expectedData:  bulkStrings("ECHO", "it's a \\n"),
+			expectedBytes: len("ECHO 'it\\'s a \\n'\r\n"),
+		},
+		"inline request with an empty quoted argument": {
+			input:         "SET key \"\"\r\n",
+			expectedData:  bulkStrings("SET", "key", ""),
+			expectedBytes: len("SET key \"\"\r\n"),
+		},
+		"inline request with an unterminated double quote is unbalanced": {
+			input:         "ECHO \"hello\r\n",
+			expectedData:  protocol.NewSimpleError("ERR Protocol error: unbalanced quotes in request"),
+			expectedBytes: len("ECHO \"hello\r\n"),
+		},
+		"inline request with a closing quote followed by text is unbalanced": {
+			input:         "ECHO \"hello\"world\r\n",
+			expectedData:  protocol.NewSimpleError("ERR Protocol error: unbalanced quotes in request"),
+			expectedBytes: len("ECHO \"hello\"world\r\n"),
+		},
+		"inline request with an unterminated single quote is unbalanced": {
+			input:         "ECHO 'hello\n",
+			expectedData:  protocol.NewSimpleError("ERR Protocol error: unbalanced quotes in request"),
+			expectedBytes: len("ECHO 'hello\n"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, byteCount := protocol.ReadRequest([]byte(tt.input))
+
+			require.Equal(t, tt.expectedData, data)
+			assert.Equal(t, tt.expectedBytes, byteCount)
+		})
+	}
+
+	t.Run("unterminated inline request that is too big is an error", func(t *testing.T) {
+		input := strings.Repeat("x", 64*1024+1)
+
+		data, byteCount := protocol.ReadRequest([]byte(input))
+
+		assert.Equal(t, protocol.NewSimpleError("ERR Protocol error: too big inline request"), data)
+		assert.Equal(t, len(input), byteCount)
+	})
+}
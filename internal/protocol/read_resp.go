@@ -3,17 +3,26 @@ package protocol
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strconv"
 )
 
 type DataTypeSymbol rune
 
 const (
-	SimpleStringSymbol  DataTypeSymbol = '+'
-	SimpleErrorSymbol   DataTypeSymbol = '-'
-	SimpleIntegerSymbol DataTypeSymbol = ':'
-	BulkStringSymbol    DataTypeSymbol = '$'
-	ArraySymbol         DataTypeSymbol = '*'
+	SimpleStringSymbol   DataTypeSymbol = '+'
+	SimpleErrorSymbol    DataTypeSymbol = '-'
+	SimpleIntegerSymbol  DataTypeSymbol = ':'
+	BulkStringSymbol     DataTypeSymbol = '$'
+	ArraySymbol          DataTypeSymbol = '*'
+	NullSymbol           DataTypeSymbol = '_'
+	MapSymbol            DataTypeSymbol = '%'
+	SetSymbol            DataTypeSymbol = '~'
+	DoubleSymbol         DataTypeSymbol = ','
+	BooleanSymbol        DataTypeSymbol = '#'
+	BigNumberSymbol      DataTypeSymbol = '('
+	VerbatimStringSymbol DataTypeSymbol = '='
+	PushSymbol           DataTypeSymbol = '>'
 )
 
 func ReadFrame(bs []byte) (Data, int) {
@@ -39,6 +48,22 @@ func ReadFrame(bs []byte) (Data, int) {
 		return parseArray(bs, text, frameSize)
 	case SimpleStringSymbol:
 		return NewSimpleString(text), frameSize
+	case NullSymbol:
+		return nil, frameSize
+	case MapSymbol:
+		return parseMap(bs, text, frameSize)
+	case SetSymbol:
+		return parseSet(bs, text, frameSize)
+	case DoubleSymbol:
+		return parseDouble(text, frameSize)
+	case BooleanSymbol:
+		return parseBoolean(text, frameSize)
+	case BigNumberSymbol:
+		return NewBigNumber(text), frameSize
+	case VerbatimStringSymbol:
+		return parseVerbatimString(text, frameSize, bs)
+	case PushSymbol:
+		return parsePush(bs, text, frameSize)
 	default:
 		return NewSimpleError(fmt.Sprintf("unknown protocol symbol \"%c\"", symbol)), frameSize
 	}
@@ -69,26 +94,141 @@ func parseBulkString(text string, frameSize int, bs []byte) (Data, int) {
 }
 
 func parseArray(bs []byte, text string, frameSize int) (Data, int) {
+	if text == "-1" {
+		return NewNullArray(), frameSize
+	}
+
 	length, _ := strconv.Atoi(text)
 
 	if length == 0 {
 		return NewArray(nil), frameSize
 	}
 
-	data := make([]Data, length)
-	for i := range length {
+	data, frameSize, errorData := parseAggregate(bs, length, frameSize)
+	if errorData != nil || data == nil {
+		return errorData, frameSize
+	}
+	return NewArray(data), frameSize
+}
+
+func parseMap(bs []byte, text string, frameSize int) (Data, int) {
+	length, err := strconv.Atoi(text)
+	if err != nil {
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a valid map length", text)), frameSize
+	}
+
+	if length == 0 {
+		return NewMap(nil), frameSize
+	}
+
+	data, frameSize, errorData := parseAggregate(bs, 2*length, frameSize)
+	if errorData != nil || data == nil {
+		return errorData, frameSize
+	}
+
+	entries := make([]MapEntry, length)
+	for i := range entries {
+		entries[i] = MapEntry{Key: data[2*i], Value: data[2*i+1]}
+	}
+	return NewMap(entries), frameSize
+}
+
+func parseSet(bs []byte, text string, frameSize int) (Data, int) {
+	length, err := strconv.Atoi(text)
+	if err != nil {
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a valid set length", text)), frameSize
+	}
+
+	if length == 0 {
+		return NewSet(nil), frameSize
+	}
+
+	data, frameSize, errorData := parseAggregate(bs, length, frameSize)
+	if errorData != nil || data == nil {
+		return errorData, frameSize
+	}
+	return NewSet(data), frameSize
+}
+
+func parsePush(bs []byte, text string, frameSize int) (Data, int) {
+	length, err := strconv.Atoi(text)
+	if err != nil {
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a valid push length", text)), frameSize
+	}
+
+	if length == 0 {
+		return NewPush(nil), frameSize
+	}
+
+	data, frameSize, errorData := parseAggregate(bs, length, frameSize)
+	if errorData != nil || data == nil {
+		return errorData, frameSize
+	}
+	return NewPush(data), frameSize
+}
+
+// parseAggregate reads the given count of frames following the header of an aggregate type. It returns nil data
+// and a zero frame size if the frames are incomplete, or the first error found within the frames.
+func parseAggregate(bs []byte, count int, frameSize int) ([]Data, int, Data) {
+	data := make([]Data, count)
+	for i := range count {
 		datum, datumSize := ReadFrame(bs[frameSize:])
 
-		if datum == nil {
-			return nil, 0
+		if datumSize == 0 {
+			return nil, 0, nil
 		}
 
 		data[i] = datum
 		frameSize += datumSize
 
 		if errorData, ok := datum.(SimpleError); ok {
-			return errorData, frameSize
+			return nil, frameSize, errorData
 		}
 	}
-	return NewArray(data), frameSize
+	return data, frameSize, nil
+}
+
+func parseDouble(text string, frameSize int) (Data, int) {
+	switch text {
+	case "inf":
+		return NewDouble(math.Inf(1)), frameSize
+	case "-inf":
+		return NewDouble(math.Inf(-1)), frameSize
+	case "nan":
+		return NewDouble(math.NaN()), frameSize
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a double", text)), frameSize
+	}
+	return NewDouble(value), frameSize
+}
+
+func parseBoolean(text string, frameSize int) (Data, int) {
+	switch text {
+	case "t":
+		return NewBoolean(true), frameSize
+	case "f":
+		return NewBoolean(false), frameSize
+	default:
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a boolean", text)), frameSize
+	}
+}
+
+func parseVerbatimString(text string, frameSize int, bs []byte) (Data, int) {
+	length, err := strconv.Atoi(text)
+	if err != nil || length < 4 {
+		return NewSimpleError(fmt.Sprintf("value \"%s\" is not a valid verbatim string length", text)), frameSize
+	}
+
+	if frameSize+length+2 > len(bs) {
+		return nil, 0
+	}
+
+	content := string(bs[frameSize : frameSize+length])
+	if content[3] != ':' {
+		return NewSimpleError(fmt.Sprintf("verbatim string \"%s\" has no format", content)), frameSize + length + 2
+	}
+	return NewVerbatimString(content[:3], content[4:]), frameSize + length + 2
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"math"
 	"redis-challenge/internal/protocol"
 	"testing"
 )
@@ -125,6 +126,11 @@ func TestParseBuffer(t *testing.T) {
 			expectedData:  protocol.NewArray(nil),
 			expectedBytes: 1 + 3,
 		},
+		"frame for a null array": {
+			input:         "*-1\r\n",
+			expectedData:  protocol.NewNullArray(),
+			expectedBytes: 1 + 4,
+		},
 		"partial frame for an array": {
 			input:         "*0",
 			expectedData:  nil,
@@ -164,6 +170,101 @@ func TestParseBuffer(t *testing.T) {
 			expectedData:  protocol.NewSimpleError("value \"not-a-number\" is not a 64-bit integer"),
 			expectedBytes: len("*1\r\n:not-a-number\r\n"),
 		},
+		"complete frame for an array containing a null bulk string": {
+			input: "*2\r\n$-1\r\n:10\r\n",
+			expectedData: protocol.NewArray(
+				[]protocol.Data{
+					nil,
+					protocol.NewSimpleInteger(10),
+				},
+			),
+			expectedBytes: len("*2\r\n$-1\r\n:10\r\n"),
+		},
+		"complete frame for a null": {
+			input:         "_\r\n",
+			expectedData:  nil,
+			expectedBytes: 3,
+		},
+		"partial frame for a map": {
+			input:         "%1\r\n+key\r\n",
+			expectedData:  nil,
+			expectedBytes: 0,
+		},
+		"complete frame for an empty map": {
+			input:         "%0\r\n",
+			expectedData:  protocol.NewMap(nil),
+			expectedBytes: len("%0\r\n"),
+		},
+		"complete frame for a map with 2 entries": {
+			input: "%2\r\n+first\r\n:1\r\n$6\r\nsecond\r\n_\r\n",
+			expectedData: protocol.NewMap(
+				[]protocol.MapEntry{
+					{Key: protocol.NewSimpleString("first"), Value: protocol.NewSimpleInteger(1)},
+					{Key: protocol.NewBulkString("second"), Value: nil},
+				},
+			),
+			expectedBytes: len("%2\r\n+first\r\n:1\r\n$6\r\nsecond\r\n_\r\n"),
+		},
+		"partial frame for a set": {
+			input:         "~2\r\n+a\r\n",
+			expectedData:  nil,
+			expectedBytes: 0,
+		},
+		"complete frame for a set with 2 members": {
+			input: "~2\r\n+a\r\n+b\r\n",
+			expectedData: protocol.NewSet(
+				[]protocol.Data{
+					protocol.NewSimpleString("a"),
+					protocol.NewSimpleString("b"),
+				},
+			),
+			expectedBytes: len("~2\r\n+a\r\n+b\r\n"),
+		},
+		"complete frame for a double": {
+			input:         ",1.25\r\n",
+			expectedData:  protocol.NewDouble(1.25),
+			expectedBytes: len(",1.25\r\n"),
+		},
+		"complete frame for a double that is negative infinity": {
+			input:         ",-inf\r\n",
+			expectedData:  protocol.NewDouble(math.Inf(-1)),
+			expectedBytes: len(",-inf\r\n"),
+		},
+		"complete frame for a double that is not a number": {
+			input:         ",abc\r\n",
+			expectedData:  protocol.NewSimpleError("value \"abc\" is not a double"),
+			expectedBytes: len(",abc\r\n"),
+		},
+		"complete frame for a true boolean": {
+			input:         "#t\r\n",
+			expectedData:  protocol.NewBoolean(true),
+			expectedBytes: 4,
+		},
+		"complete frame for a false boolean": {
+			input:         "#f\r\n",
+			expectedData:  protocol.NewBoolean(false),
+			expectedBytes: 4,
+		},
+		"complete frame for a boolean that is neither true or false": {
+			input:         "#x\r\n",
+			expectedData:  protocol.NewSimpleError("value \"x\" is not a boolean"),
+			expectedBytes: 4,
+		},
+		"complete frame for a big number": {
+			input:         "(3492890328409238509324850943850943825024385\r\n",
+			expectedData:  protocol.NewBigNumber("3492890328409238509324850943850943825024385"),
+			expectedBytes: len("(3492890328409238509324850943850943825024385\r\n"),
+		},
+		"partial frame for a verbatim string": {
+			input:         "=15\r\ntxt:Some",
+			expectedData:  nil,
+			expectedBytes: 0,
+		},
+		"complete frame for a verbatim string": {
+			input:         "=15\r\ntxt:Some string\r\n",
+			expectedData:  protocol.NewVerbatimString("txt", "Some string"),
+			expectedBytes: len("=15\r\ntxt:Some string\r\n"),
+		},
 		"frame with an unknown prefix": {
 			input:         "xyz\r\n",
 			expectedData:  protocol.NewSimpleError("unknown protocol symbol \"x\""),
@@ -6,11 +6,29 @@ import (
 	"strconv"
 )
 
+// Version is the version of the RESP protocol negotiated by a client connection.
+type Version int
+
+const (
+	Version2 Version = 2
+	Version3 Version = 3
+)
+
+// WriteData writes data using the RESP2 protocol, with RESP3 types written as their closest RESP2 equivalent.
 func WriteData(out io.Writer, data Data) error {
+	return WriteDataWithVersion(out, data, Version2)
+}
+
+// WriteDataWithVersion writes data using the given version of the RESP protocol.
+func WriteDataWithVersion(out io.Writer, data Data, version Version) error {
 	var text string
 	switch d := data.(type) {
 	case nil:
-		text = "$-1\r\n"
+		if version == Version3 {
+			text = "_\r\n"
+		} else {
+			text = "$-1\r\n"
+		}
 	case SimpleString:
 		return writeString(out, SimpleStringSymbol, string(d))
 	case SimpleError:
@@ -20,9 +38,53 @@ func WriteData(out io.Writer, data Data) error {
 	case BulkString:
 		return writeBulkString(out, d)
 	case Array:
-		return writeArray(out, d)
+		return writeArray(out, ArraySymbol, d.Data, version)
+	case NullArray:
+		if version == Version3 {
+			text = "_\r\n"
+		} else {
+			text = "*-1\r\n"
+		}
 	case DoubleEndedList:
 		return writeDoubleEndedList(out, d)
+	case Map:
+		return writeMap(out, d, version)
+	case Pairs:
+		return writePairs(out, d, version)
+	case Set:
+		if version == Version3 {
+			return writeArray(out, SetSymbol, d.Data, version)
+		}
+		return writeArray(out, ArraySymbol, d.Data, version)
+	case Double:
+		if version == Version3 {
+			return writeString(out, DoubleSymbol, FormatDouble(float64(d)))
+		}
+		return writeBulkString(out, BulkString(FormatDouble(float64(d))))
+	case Boolean:
+		return writeBoolean(out, d, version)
+	case BigNumber:
+		if version == Version3 {
+			return writeString(out, BigNumberSymbol, string(d))
+		}
+		return writeBulkString(out, BulkString(d))
+	case VerbatimString:
+		if version == Version3 {
+			return writeVerbatimString(out, d)
+		}
+		return writeBulkString(out, BulkString(d.Text))
+	case Push:
+		if version == Version3 {
+			return writeArray(out, PushSymbol, d.Data, version)
+		}
+		return writeArray(out, ArraySymbol, d.Data, version)
+	case Replies:
+		for _, reply := range d.Data {
+			if err := WriteDataWithVersion(out, reply, version); err != nil {
+				return err
+			}
+		}
+		return nil
 	default:
 		text = fmt.Sprintf("-ERR unknown data type\r\n")
 	}
@@ -64,13 +126,13 @@ func writeBulkString(out io.Writer, data BulkString) error {
 	return nil
 }
 
-func writeArray(out io.Writer, d Array) error {
-	if err := writeNumber(out, ArraySymbol, int64(len(d.Data))); err != nil {
+func writeArray(out io.Writer, symbol DataTypeSymbol, data []Data, version Version) error {
+	if err := writeNumber(out, symbol, int64(len(data))); err != nil {
 		return err
 	}
 
-	for _, item := range d.Data {
-		if err := WriteData(out, item); err != nil {
+	for _, item := range data {
+		if err := WriteDataWithVersion(out, item, version); err != nil {
 			return err
 		}
 	}
@@ -91,3 +153,73 @@ func writeDoubleEndedList(out io.Writer, d DoubleEndedList) error {
 
 	return nil
 }
+
+func writeMap(out io.Writer, d Map, version Version) error {
+	var err error
+	if version == Version3 {
+		err = writeNumber(out, MapSymbol, int64(len(d.Entries)))
+	} else {
+		err = writeNumber(out, ArraySymbol, int64(2*len(d.Entries)))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range d.Entries {
+		if err := WriteDataWithVersion(out, entry.Key, version); err != nil {
+			return err
+		}
+		if err := WriteDataWithVersion(out, entry.Value, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writePairs(out io.Writer, d Pairs, version Version) error {
+	if version != Version3 {
+		return writeMap(out, NewMap(d.Entries), version)
+	}
+
+	if err := writeNumber(out, ArraySymbol, int64(len(d.Entries))); err != nil {
+		return err
+	}
+
+	for _, entry := range d.Entries {
+		if err := writeArray(out, ArraySymbol, []Data{entry.Key, entry.Value}, version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeBoolean(out io.Writer, d Boolean, version Version) error {
+	if version == Version3 {
+		if d {
+			return writeString(out, BooleanSymbol, "t")
+		}
+		return writeString(out, BooleanSymbol, "f")
+	}
+
+	if d {
+		return writeNumber(out, SimpleIntegerSymbol, 1)
+	}
+	return writeNumber(out, SimpleIntegerSymbol, 0)
+}
+
+func writeVerbatimString(out io.Writer, d VerbatimString) error {
+	content := d.Format + ":" + d.Text
+	if err := writeNumber(out, VerbatimStringSymbol, int64(len(content))); err != nil {
+		return err
+	}
+
+	if _, err := out.Write([]byte(content)); err != nil {
+		return err
+	}
+	if _, err := out.Write([]byte("\r\n")); err != nil {
+		return err
+	}
+	return nil
+}
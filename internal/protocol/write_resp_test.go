@@ -17,6 +17,7 @@ func TestWritingData(t *testing.T) {
 		"bulk string":    "$5\r\nabcde\r\n",
 		"array":          "*2\r\n+abcde\r\n:42\r\n",
 		"nil":            "$-1\r\n",
+		"null array":     "*-1\r\n",
 	}
 
 	for testName, message := range tests {
@@ -34,3 +35,127 @@ func TestWritingData(t *testing.T) {
 		})
 	}
 }
+
+func TestWritingDataWithVersion3(t *testing.T) {
+
+	tests := map[string]string{
+		"simple string":   "+message\r\n",
+		"bulk string":     "$5\r\nabcde\r\n",
+		"null":            "_\r\n",
+		"array":           "*2\r\n+abcde\r\n_\r\n",
+		"map":             "%2\r\n+first\r\n:1\r\n+second\r\n,1.5\r\n",
+		"set":             "~2\r\n+a\r\n+b\r\n",
+		"double":          ",3.25\r\n",
+		"infinite double": ",inf\r\n",
+		"boolean":         "#t\r\n",
+		"big number":      "(3492890328409238509324850943850943825024385\r\n",
+		"verbatim string": "=15\r\ntxt:Some string\r\n",
+		"push":            ">2\r\n$7\r\nmessage\r\n$5\r\nhello\r\n",
+	}
+
+	for testName, message := range tests {
+		t.Run(testName, func(t *testing.T) {
+			data, _ := protocol.ReadFrame([]byte(message))
+
+			var outBuffer bytes.Buffer
+			err := protocol.WriteDataWithVersion(&outBuffer, data, protocol.Version3)
+			require.NoError(t, err)
+
+			assert.Equal(t, message, outBuffer.String())
+		})
+	}
+}
+
+func TestWritingPairsWithVersion3(t *testing.T) {
+	data := protocol.NewPairs([]protocol.MapEntry{
+		{Key: protocol.NewBulkString("a"), Value: protocol.NewDouble(1)},
+		{Key: protocol.NewBulkString("b"), Value: protocol.NewDouble(2.5)},
+	})
+
+	var outBuffer bytes.Buffer
+	err := protocol.WriteDataWithVersion(&outBuffer, data, protocol.Version3)
+	require.NoError(t, err)
+
+	assert.Equal(t, "*2\r\n*2\r\n$1\r\na\r\n,1\r\n*2\r\n$1\r\nb\r\n,2.5\r\n", outBuffer.String())
+}
+
+func TestWritingVersion3DataWithVersion2(t *testing.T) {
+
+	tests := map[string]struct {
+		data     protocol.Data
+		expected string
+	}{
+		"null is a null bulk string": {
+			data:     nil,
+			expected: "$-1\r\n",
+		},
+		"null array is a null array": {
+			data:     protocol.NewNullArray(),
+			expected: "*-1\r\n",
+		},
+		"map is a flattened array of keys and values": {
+			data: protocol.NewMap([]protocol.MapEntry{
+				{Key: protocol.NewBulkString("key"), Value: protocol.NewSimpleInteger(1)},
+			}),
+			expected: "*2\r\n$3\r\nkey\r\n:1\r\n",
+		},
+		"pairs are a flattened array of keys and values": {
+			data: protocol.NewPairs([]protocol.MapEntry{
+				{Key: protocol.NewBulkString("member"), Value: protocol.NewDouble(1.5)},
+			}),
+			expected: "*2\r\n$6\r\nmember\r\n$3\r\n1.5\r\n",
+		},
+		"set is an array": {
+			data:     protocol.NewSet([]protocol.Data{protocol.NewBulkString("a")}),
+			expected: "*1\r\n$1\r\na\r\n",
+		},
+		"double is a bulk string": {
+			data:     protocol.NewDouble(1.5),
+			expected: "$3\r\n1.5\r\n",
+		},
+		"true boolean is integer 1": {
+			data:     protocol.NewBoolean(true),
+			expected: ":1\r\n",
+		},
+		"false boolean is integer 0": {
+			data:     protocol.NewBoolean(false),
+			expected: ":0\r\n",
+		},
+		"big number is a bulk string": {
+			data:     protocol.NewBigNumber("12345678901234567890"),
+			expected: "$20\r\n12345678901234567890\r\n",
+		},
+		"verbatim string is a bulk string without its format": {
+			data:     protocol.NewVerbatimString("txt", "message"),
+			expected: "$7\r\nmessage\r\n",
+		},
+		"push is an array": {
+			data:     protocol.NewPush([]protocol.Data{protocol.NewBulkString("message")}),
+			expected: "*1\r\n$7\r\nmessage\r\n",
+		},
+		"replies are written one after another": {
+			data: protocol.NewReplies([]protocol.Data{
+				protocol.NewPush([]protocol.Data{protocol.NewBulkString("a")}),
+				protocol.NewSimpleInteger(1),
+			}),
+			expected: "*1\r\n$1\r\na\r\n:1\r\n",
+		},
+		"nested version 3 types are also converted": {
+			data: protocol.NewArray([]protocol.Data{
+				protocol.NewDouble(2),
+				protocol.NewBoolean(true),
+			}),
+			expected: "*2\r\n$1\r\n2\r\n:1\r\n",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var outBuffer bytes.Buffer
+			err := protocol.WriteData(&outBuffer, testCase.data)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, outBuffer.String())
+		})
+	}
+}
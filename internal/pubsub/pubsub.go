@@ -0,0 +1,344 @@
+package pubsub
+
+import (
+	"redis-challenge/internal/glob"
+	"redis-challenge/internal/protocol"
+	"slices"
+	"sync"
+)
+
+// Subscriber is a connection subscribed to channels, patterns or shard channels, which queues the messages published
+// to it until the connection writes them.
+type Subscriber struct {
+	mutex         sync.Mutex
+	pending       []protocol.Data
+	ready         chan struct{}
+	channels      []string
+	patterns      []string
+	shardChannels []string
+}
+
+func NewSubscriber() *Subscriber {
+	return &Subscriber{ready: make(chan struct{}, 1)}
+}
+
+// Ready signals that messages are waiting to be taken.
+func (s *Subscriber) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Take removes and returns the messages waiting, in the order they were published.
+func (s *Subscriber) Take() []protocol.Data {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pending := s.pending
+	s.pending = nil
+	return pending
+}
+
+// Count returns the number of channels and patterns subscribed to, leaving out shard channels.
+func (s *Subscriber) Count() int {
+	return len(s.channels) + len(s.patterns)
+}
+
+// ShardCount returns the number of shard channels subscribed to.
+func (s *Subscriber) ShardCount() int {
+	return len(s.shardChannels)
+}
+
+// Channels returns the channels subscribed to, in the order they were subscribed.
+func (s *Subscriber) Channels() []string {
+	return slices.Clone(s.channels)
+}
+
+// Patterns returns the patterns subscribed to, in the order they were subscribed.
+func (s *Subscriber) Patterns() []string {
+	return slices.Clone(s.patterns)
+}
+
+// ShardChannels returns the shard channels subscribed to, in the order they were subscribed.
+func (s *Subscriber) ShardChannels() []string {
+	return slices.Clone(s.shardChannels)
+}
+
+// deliver queues the message without waiting, so a slow subscriber never holds up a publisher.
+func (s *Subscriber) deliver(message protocol.Data) {
+	s.mutex.Lock()
+	s.pending = append(s.pending, message)
+	s.mutex.Unlock()
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Registry holds the subscribers of every channel and pattern, so a message published to a channel reaches the
+// subscribers of the channel and of every pattern matching it. It is shared by every connection.
+//
+// Shard channels are a separate namespace that patterns never match. They are held by the hash slot of their name,
+// as a Redis cluster assigns each slot to the shard that the messages of its channels are published on.
+type Registry struct {
+	mutex         sync.Mutex
+	channels      map[string]map[*Subscriber]struct{}
+	patterns      map[string]map[*Subscriber]struct{}
+	shardChannels map[int]map[string]map[*Subscriber]struct{}
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		channels:      make(map[string]map[*Subscriber]struct{}),
+		patterns:      make(map[string]map[*Subscriber]struct{}),
+		shardChannels: make(map[int]map[string]map[*Subscriber]struct{}),
+	}
+}
+
+// Subscribe subscribes to the channel, returning the number of subscriptions the subscriber then has.
+func (r *Registry) Subscribe(s *Subscriber, channel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if add(r.channels, channel, s) {
+		s.channels = append(s.channels, channel)
+	}
+	return s.Count()
+}
+
+// Unsubscribe unsubscribes from the channel, returning the number of subscriptions the subscriber then has.
+func (r *Registry) Unsubscribe(s *Subscriber, channel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if remove(r.channels, channel, s) {
+		s.channels = slices.DeleteFunc(s.channels, func(c string) bool { return c == channel })
+	}
+	return s.Count()
+}
+
+// PSubscribe subscribes to the channels matching the glob-style pattern, returning the number of subscriptions the
+// subscriber then has.
+func (r *Registry) PSubscribe(s *Subscriber, pattern string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if add(r.patterns, pattern, s) {
+		s.patterns = append(s.patterns, pattern)
+	}
+	return s.Count()
+}
+
+// PUnsubscribe unsubscribes from the pattern, returning the number of subscriptions the subscriber then has.
+func (r *Registry) PUnsubscribe(s *Subscriber, pattern string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if remove(r.patterns, pattern, s) {
+		s.patterns = slices.DeleteFunc(s.patterns, func(p string) bool { return p == pattern })
+	}
+	return s.Count()
+}
+
+// SSubscribe subscribes to the shard channel, returning the number of shard channels the subscriber then has.
+func (r *Registry) SSubscribe(s *Subscriber, shardChannel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	slot := Slot(shardChannel)
+	if _, ok := r.shardChannels[slot]; !ok {
+		r.shardChannels[slot] = make(map[string]map[*Subscriber]struct{})
+	}
+
+	if add(r.shardChannels[slot], shardChannel, s) {
+		s.shardChannels = append(s.shardChannels, shardChannel)
+	}
+	return s.ShardCount()
+}
+
+// SUnsubscribe unsubscribes from the shard channel, returning the number of shard channels the subscriber then has.
+func (r *Registry) SUnsubscribe(s *Subscriber, shardChannel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.removeShardChannel(shardChannel, s) {
+		s.shardChannels = slices.DeleteFunc(s.shardChannels, func(c string) bool { return c == shardChannel })
+	}
+	return s.ShardCount()
+}
+
+// removeShardChannel removes the subscriber from the subscribers of the shard channel, forgetting the slot of the
+// channel once none of its channels have subscribers.
+func (r *Registry) removeShardChannel(shardChannel string, s *Subscriber) bool {
+	slot := Slot(shardChannel)
+	subscriptions, ok := r.shardChannels[slot]
+	if !ok || !remove(subscriptions, shardChannel, s) {
+		return false
+	}
+
+	if len(subscriptions) == 0 {
+		delete(r.shardChannels, slot)
+	}
+	return true
+}
+
+// UnsubscribeAll removes every subscription of the subscriber, as when its connection closes.
+func (r *Registry) UnsubscribeAll(s *Subscriber) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, channel := range s.channels {
+		remove(r.channels, channel, s)
+	}
+	for _, pattern := range s.patterns {
+		remove(r.patterns, pattern, s)
+	}
+	for _, shardChannel := range s.shardChannels {
+		r.removeShardChannel(shardChannel, s)
+	}
+	s.channels, s.patterns, s.shardChannels = nil, nil, nil
+}
+
+// Publish sends the message to the subscribers of the channel and of the patterns matching it, returning the number
+// of subscribers that receive it, where a subscriber matching in several ways receives it once for each.
+func (r *Registry) Publish(channel string, message string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	received := 0
+	if subscribers, ok := r.channels[channel]; ok {
+		push := protocol.NewPush([]protocol.Data{
+			protocol.NewBulkString("message"),
+			protocol.NewBulkString(channel),
+			protocol.NewBulkString(message),
+		})
+		for s := range subscribers {
+			s.deliver(push)
+			received++
+		}
+	}
+
+	for pattern, subscribers := range r.patterns {
+		if !glob.Match(pattern, channel) {
+			continue
+		}
+
+		push := protocol.NewPush([]protocol.Data{
+			protocol.NewBulkString("pmessage"),
+			protocol.NewBulkString(pattern),
+			protocol.NewBulkString(channel),
+			protocol.NewBulkString(message),
+		})
+		for s := range subscribers {
+			s.deliver(push)
+			received++
+		}
+	}
+	return received
+}
+
+// SPublish sends the message to the subscribers of the shard channel, returning the number of subscribers that
+// receive it.
+func (r *Registry) SPublish(shardChannel string, message string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	subscribers := r.shardChannels[Slot(shardChannel)][shardChannel]
+	if len(subscribers) == 0 {
+		return 0
+	}
+
+	push := protocol.NewPush([]protocol.Data{
+		protocol.NewBulkString("smessage"),
+		protocol.NewBulkString(shardChannel),
+		protocol.NewBulkString(message),
+	})
+	for s := range subscribers {
+		s.deliver(push)
+	}
+	return len(subscribers)
+}
+
+// Channels returns the channels with at least one subscriber, in order.
+func (r *Registry) Channels() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	channels := make([]string, 0, len(r.channels))
+	for channel := range r.channels {
+		channels = append(channels, channel)
+	}
+	slices.Sort(channels)
+	return channels
+}
+
+// ShardChannels returns the shard channels with at least one subscriber, in order.
+func (r *Registry) ShardChannels() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var shardChannels []string
+	for _, subscriptions := range r.shardChannels {
+		for shardChannel := range subscriptions {
+			shardChannels = append(shardChannels, shardChannel)
+		}
+	}
+	slices.Sort(shardChannels)
+	return shardChannels
+}
+
+// SubscriberCount returns the number of subscribers of the channel, leaving out subscribers of patterns.
+func (r *Registry) SubscriberCount(channel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.channels[channel])
+}
+
+// ShardSubscriberCount returns the number of subscribers of the shard channel.
+func (r *Registry) ShardSubscriberCount(shardChannel string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.shardChannels[Slot(shardChannel)][shardChannel])
+}
+
+// PatternCount returns the number of patterns with at least one subscriber.
+func (r *Registry) PatternCount() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.patterns)
+}
+
+// add adds the subscriber to the subscribers of the name, returning false if it was already subscribed.
+func add(subscriptions map[string]map[*Subscriber]struct{}, name string, s *Subscriber) bool {
+	subscribers, ok := subscriptions[name]
+	if !ok {
+		subscribers = make(map[*Subscriber]struct{})
+		subscriptions[name] = subscribers
+	}
+
+	if _, ok := subscribers[s]; ok {
+		return false
+	}
+	subscribers[s] = struct{}{}
+	return true
+}
+
+// remove removes the subscriber from the subscribers of the name, forgetting the name once it has no subscribers.
+// It returns false if the subscriber was not subscribed.
+func remove(subscriptions map[string]map[*Subscriber]struct{}, name string, s *Subscriber) bool {
+	subscribers, ok := subscriptions[name]
+	if !ok {
+		return false
+	}
+	if _, ok := subscribers[s]; !ok {
+		return false
+	}
+
+	delete(subscribers, s)
+	if len(subscribers) == 0 {
+		delete(subscriptions, name)
+	}
+	return true
+}
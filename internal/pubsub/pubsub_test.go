@@ -0,0 +1,120 @@
+package pubsub_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
+	"testing"
+)
+
+func message(parts ...string) protocol.Data {
+	data := make([]protocol.Data, len(parts))
+	for i, part := range parts {
+		data[i] = protocol.NewBulkString(part)
+	}
+	return protocol.NewPush(data)
+}
+
+func TestRegistry(t *testing.T) {
+
+	t.Run("a subscriber receives messages in the order they were published", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		subscriber := pubsub.NewSubscriber()
+		registry.Subscribe(subscriber, "news")
+
+		assert.Equal(t, 1, registry.Publish("news", "first"))
+		assert.Equal(t, 1, registry.Publish("news", "second"))
+
+		assert.Len(t, subscriber.Ready(), 1)
+		assert.Equal(t, []protocol.Data{message("message", "news", "first"), message("message", "news", "second")}, subscriber.Take())
+		assert.Empty(t, subscriber.Take())
+	})
+
+	t.Run("a subscriber of a channel and a matching pattern receives a message for each", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		subscriber := pubsub.NewSubscriber()
+		registry.Subscribe(subscriber, "news.tech")
+		registry.PSubscribe(subscriber, "news.*")
+		registry.PSubscribe(subscriber, "sport.*")
+
+		assert.Equal(t, 2, registry.Publish("news.tech", "launch"))
+		assert.Equal(t, []protocol.Data{
+			message("message", "news.tech", "launch"),
+			message("pmessage", "news.*", "news.tech", "launch"),
+		}, subscriber.Take())
+	})
+
+	t.Run("subscribing twice counts one subscription", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		subscriber := pubsub.NewSubscriber()
+
+		assert.Equal(t, 1, registry.Subscribe(subscriber, "news"))
+		assert.Equal(t, 1, registry.Subscribe(subscriber, "news"))
+		assert.Equal(t, 2, registry.PSubscribe(subscriber, "news"))
+		assert.Equal(t, 1, registry.SubscriberCount("news"))
+	})
+
+	t.Run("unsubscribing forgets channels without subscribers", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		first, second := pubsub.NewSubscriber(), pubsub.NewSubscriber()
+		registry.Subscribe(first, "news")
+		registry.Subscribe(first, "sport")
+		registry.Subscribe(second, "news")
+
+		assert.Equal(t, 1, registry.Unsubscribe(first, "news"))
+		assert.Equal(t, 1, registry.Unsubscribe(first, "missing"))
+		assert.Equal(t, []string{"news", "sport"}, registry.Channels())
+
+		registry.Unsubscribe(second, "news")
+		assert.Equal(t, []string{"sport"}, registry.Channels())
+		assert.Equal(t, 0, registry.Publish("news", "hello"))
+	})
+
+	t.Run("unsubscribing from everything removes every subscription", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		subscriber := pubsub.NewSubscriber()
+		registry.Subscribe(subscriber, "news")
+		registry.PSubscribe(subscriber, "sport.*")
+
+		registry.UnsubscribeAll(subscriber)
+
+		assert.Equal(t, 0, subscriber.Count())
+		assert.Empty(t, registry.Channels())
+		assert.Equal(t, 0, registry.PatternCount())
+		assert.Equal(t, 0, registry.Publish("sport.football", "goal"))
+	})
+
+	t.Run("shard channels are apart from channels and patterns", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		subscriber := pubsub.NewSubscriber()
+		registry.Subscribe(subscriber, "news")
+		registry.PSubscribe(subscriber, "*")
+
+		assert.Equal(t, 1, registry.SSubscribe(subscriber, "news"))
+		assert.Equal(t, 2, subscriber.Count())
+		assert.Equal(t, 1, subscriber.ShardCount())
+
+		assert.Equal(t, 1, registry.SPublish("news", "sharded"))
+		assert.Equal(t, 0, registry.SPublish("sport", "sharded"))
+		assert.Equal(t, []protocol.Data{message("smessage", "news", "sharded")}, subscriber.Take())
+
+		assert.Equal(t, []string{"news"}, registry.ShardChannels())
+		assert.Equal(t, 1, registry.ShardSubscriberCount("news"))
+		assert.Equal(t, 0, registry.SUnsubscribe(subscriber, "news"))
+		assert.Empty(t, registry.ShardChannels())
+		assert.Equal(t, []string{"news"}, registry.Channels())
+	})
+
+	t.Run("shard channels sharing a slot are kept apart", func(t *testing.T) {
+		registry := pubsub.NewRegistry()
+		first, second := pubsub.NewSubscriber(), pubsub.NewSubscriber()
+		registry.SSubscribe(first, "{user}.followers")
+		registry.SSubscribe(second, "{user}.following")
+
+		assert.Equal(t, 1, registry.SPublish("{user}.followers", "hello"))
+		assert.Empty(t, second.Take())
+
+		registry.UnsubscribeAll(first)
+		assert.Equal(t, []string{"{user}.following"}, registry.ShardChannels())
+	})
+}
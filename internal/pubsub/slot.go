@@ -0,0 +1,34 @@
+package pubsub
+
+import "strings"
+
+// SlotCount is the number of hash slots a Redis cluster divides keys and shard channels between.
+const SlotCount = 16384
+
+// Slot returns the hash slot of the key or shard channel, which is the CRC16 of its hash tag, the part between the
+// first { and the following }, if that is not empty, or otherwise of the whole name. Names sharing a hash tag are
+// always assigned the same slot.
+func Slot(name string) int {
+	if start := strings.IndexByte(name, '{'); start >= 0 {
+		if length := strings.IndexByte(name[start+1:], '}'); length > 0 {
+			name = name[start+1 : start+1+length]
+		}
+	}
+	return int(crc16(name) % SlotCount)
+}
+
+// crc16 returns the CRC16 checksum of the text using the XMODEM polynomial, as Redis cluster does.
+func crc16(text string) uint16 {
+	var crc uint16
+	for i := 0; i < len(text); i++ {
+		crc ^= uint16(text[i]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,31 @@
+package pubsub_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"redis-challenge/internal/pubsub"
+	"testing"
+)
+
+func TestSlot(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		expected int
+	}{
+		"the slot is the checksum of the name":       {name: "123456789", expected: 12739},
+		"names without a hash tag are hashed whole":  {name: "foo", expected: 12182},
+		"the hash tag is hashed instead of the name": {name: "{foo}.bar", expected: 12182},
+		"only the first hash tag is hashed":          {name: "{foo}{bar}", expected: 12182},
+		"the empty name has the first slot":          {name: "", expected: 0},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, pubsub.Slot(testCase.name))
+		})
+	}
+
+	t.Run("empty and unclosed hash tags are not hash tags", func(t *testing.T) {
+		assert.NotEqual(t, pubsub.Slot("foo"), pubsub.Slot("{}foo"))
+		assert.NotEqual(t, pubsub.Slot("foo"), pubsub.Slot("{foo"))
+	})
+}
@@ -0,0 +1,87 @@
+package scan
+
+import (
+	"cmp"
+	"hash/fnv"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+// PositionBits is the number of bits in a scan position, which is small enough for every position to be held
+// exactly as the score of a sorted set.
+const PositionBits = 53
+
+// Position returns the position of a key or member in a scan, which is its hash with the bits reversed. Redis
+// visits the buckets of its table in this order, so a cursor is not invalidated when the table grows or shrinks.
+func Position(member string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	return bits.Reverse64(h.Sum64()) >> (64 - PositionBits)
+}
+
+type positioned struct {
+	position uint64
+	member   string
+}
+
+// Order holds the members of a collection sorted by their scan position, so each page of a scan is found by a
+// binary search rather than by sorting the whole collection. It is sorted when the collection is first scanned and
+// forgotten with Reset whenever a member is added or removed, so a scan that is not interleaved with changes to the
+// collection sorts it only once.
+type Order struct {
+	sorted []positioned
+	valid  bool
+}
+
+// Reset forgets the order, which must be called whenever a member is added to or removed from the collection.
+func (o *Order) Reset() {
+	o.sorted = nil
+	o.valid = false
+}
+
+// Page returns at least count of the members from the cursor onwards, or every remaining member, with the cursor to
+// continue from, which is zero once every member has been returned. Members sharing a position are returned
+// together, so every member that is in the collection for the whole of a scan is returned at least once.
+func (o *Order) Page(members iter.Seq[string], cursor uint64, count int) (uint64, []string) {
+	if !o.valid {
+		o.sort(members)
+	}
+
+	start, _ := slices.BinarySearchFunc(o.sorted, cursor, func(p positioned, cursor uint64) int {
+		return cmp.Compare(p.position, cursor)
+	})
+	remaining := o.sorted[start:]
+
+	next := uint64(0)
+	end := len(remaining)
+	if count < end {
+		end = count
+		for end < len(remaining) && remaining[end].position == remaining[end-1].position {
+			end++
+		}
+		if end < len(remaining) {
+			next = remaining[end].position
+		}
+	}
+
+	page := make([]string, end)
+	for i, p := range remaining[:end] {
+		page[i] = p.member
+	}
+	return next, page
+}
+
+func (o *Order) sort(members iter.Seq[string]) {
+	o.sorted = o.sorted[:0]
+	for member := range members {
+		o.sorted = append(o.sorted, positioned{position: Position(member), member: member})
+	}
+	slices.SortFunc(o.sorted, func(a, b positioned) int {
+		if a.position != b.position {
+			return cmp.Compare(a.position, b.position)
+		}
+		return cmp.Compare(a.member, b.member)
+	})
+	o.valid = true
+}
@@ -0,0 +1,57 @@
+package scan_test
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"redis-challenge/internal/scan"
+	"slices"
+	"testing"
+)
+
+func TestOrder(t *testing.T) {
+
+	scanAll := func(order *scan.Order, members []string, count int) []string {
+		var found []string
+		cursor := uint64(0)
+		for {
+			next, page := order.Page(slices.Values(members), cursor, count)
+			found = append(found, page...)
+			if next == 0 {
+				return found
+			}
+			cursor = next
+		}
+	}
+
+	t.Run("pages return every member once", func(t *testing.T) {
+		var members []string
+		for i := range 100 {
+			members = append(members, fmt.Sprintf("member%d", i))
+		}
+
+		assert.ElementsMatch(t, members, scanAll(&scan.Order{}, members, 7))
+	})
+
+	t.Run("pages are in the order of the positions of their members", func(t *testing.T) {
+		members := []string{"a", "b", "c", "d", "e"}
+
+		found := scanAll(&scan.Order{}, members, 1)
+
+		assert.True(t, slices.IsSortedFunc(found, func(a, b string) int {
+			return cmp.Compare(scan.Position(a), scan.Position(b))
+		}))
+	})
+
+	t.Run("members added after the order is reset are found", func(t *testing.T) {
+		order := &scan.Order{}
+		members := []string{"a", "b"}
+		assert.ElementsMatch(t, members, scanAll(order, members, 10))
+
+		members = append(members, "c")
+		assert.ElementsMatch(t, []string{"a", "b"}, scanAll(order, members, 10))
+
+		order.Reset()
+		assert.ElementsMatch(t, members, scanAll(order, members, 10))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 
 	"redis-challenge/internal/command"
+	"redis-challenge/internal/pubsub"
 	"redis-challenge/internal/store"
 )
 
@@ -56,6 +57,7 @@ func NewChallengeServer(port int, builder store.Builder) *ChallengeServerBuilder
 		port:    port,
 		builder: builder,
 		writer:  io.Discard,
+		clock:   store.SystemClock{},
 	}
 }
 
@@ -85,13 +87,14 @@ func (b *ChallengeServerBuilder) Start() (*ChallengeServer, error) {
 		return nil, err
 	}
 
-	s, scanner := b.builder.WithCommandLogWriter(b.writer).Build()
+	registry := pubsub.NewRegistry()
+	databases := b.builder.WithCommandLogWriter(b.writer).WithKeyspacePublisher(registry).Build()
 
 	validator := command.NewValidator(b.clock)
 
 	if b.reader != nil {
 		r := restorer{
-			store:     s,
+			databases: databases,
 			validator: validator,
 		}
 
@@ -104,8 +107,10 @@ func (b *ChallengeServerBuilder) Start() (*ChallengeServer, error) {
 	ctx, cancelFunction := context.WithCancel(context.Background())
 
 	handler := connectionHandler{
-		executor:  command.NewStoreExecutor(ctx, s, scanner, b.writer),
-		validator: validator,
+		executor:      command.NewStoreExecutor(ctx, databases, b.clock),
+		validator:     validator,
+		databaseCount: databases.Count(),
+		registry:      registry,
 	}
 
 	go func() {
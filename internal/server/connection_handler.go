@@ -1,17 +1,22 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"io"
 	"log/slog"
 	"net"
 	"redis-challenge/internal/command"
 	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
 )
 
 type connectionHandler struct {
-	executor  command.Executor
-	validator command.Validator
+	executor      command.Executor
+	validator     command.Validator
+	databaseCount int
+	registry      *pubsub.Registry
 }
 
 func (h connectionHandler) HandleConnection(connection net.Conn) {
@@ -22,67 +27,195 @@ func (h connectionHandler) HandleConnection(connection net.Conn) {
 		}
 	}()
 
+	session := command.NewSession(h.databaseCount, h.registry)
+	defer h.registry.UnsubscribeAll(session.Subscriber)
+
+	reads, disconnected := readConnection(connection)
+
 	var buffer bytes.Buffer
+	out := bufio.NewWriter(connection)
 
-	readBuffer := make([]byte, 1024)
 	for {
-		bytesRead, err := connection.Read(readBuffer)
+		// messages published to the subscriptions of the connection are written between the responses to requests
+		select {
+		case read, ok := <-reads:
+			if !ok {
+				return
+			}
+			buffer.Write(read)
+
+			requestByteCount := h.executeFrames(session, buffer.Bytes(), out, disconnected)
+			buffer.Next(requestByteCount)
+
+		case <-session.Subscriber.Ready():
+			writeMessages(session, out)
+		}
+
+		flush(out)
+	}
+}
+
+// flush sends what has been written to the connection.
+func flush(out *bufio.Writer) {
+	err := out.Flush()
+	if err != nil {
+		slog.Error("failed to send responses", "error", err)
+	}
+}
+
+// writeMessages writes the messages waiting for the subscriber of the session, in the order they were published.
+func writeMessages(session *command.Session, out io.Writer) {
+	for _, message := range session.Subscriber.Take() {
+		err := protocol.WriteDataWithVersion(out, message, session.ProtocolVersion)
 		if err != nil {
-			if err == io.EOF {
+			slog.Error("failed to write message", "error", err)
+		}
+	}
+}
+
+// connectionReadQueueSize is the number of reads that can be queued while a command is executing, so a
+// disconnect is still noticed while a blocking command waits.
+const connectionReadQueueSize = 16
+
+// readConnection reads from the connection in the background, so a client disconnecting is noticed whilst a
+// blocking command is waiting. The reads channel is closed after the disconnected channel once reading fails.
+func readConnection(connection net.Conn) (<-chan []byte, <-chan struct{}) {
+	reads := make(chan []byte, connectionReadQueueSize)
+	disconnected := make(chan struct{})
+
+	go func() {
+		defer close(reads)
+		defer close(disconnected)
+
+		for {
+			readBuffer := make([]byte, 1024)
+			bytesRead, err := connection.Read(readBuffer)
+			if err != nil {
+				if err != io.EOF && !errors.Is(err, net.ErrClosed) {
+					slog.Error("failed to read request", "error", err)
+				}
 				return
 			}
+			reads <- readBuffer[:bytesRead]
+		}
+	}()
 
-			slog.Error("failed to read request", "error", err)
-			return
+	return reads, disconnected
+}
+
+// executeFrames executes every complete frame at the start of the request bytes, writing the responses
+// in order, and returns the count of bytes consumed so any partial trailing frame is kept for the next read.
+func (h connectionHandler) executeFrames(session *command.Session, requestBytes []byte, out *bufio.Writer, disconnected <-chan struct{}) int {
+	offset := 0
+	for {
+		protocolData, frameByteCount := protocol.ReadRequest(requestBytes[offset:])
+		if frameByteCount == 0 {
+			return offset
 		}
-		buffer.Write(readBuffer[:bytesRead])
 
-		protocolData, requestByteCount := protocol.ReadFrame(buffer.Bytes())
-		if requestByteCount == 0 {
+		frameBytes := requestBytes[offset : offset+frameByteCount]
+		offset += frameByteCount
+
+		if array, ok := protocolData.(protocol.Array); ok && len(array.Data) == 0 {
+			// empty requests, such as a blank inline line, are ignored without a reply
 			continue
 		}
-		requestBytes := buffer.Bytes()[:requestByteCount]
-		response := h.executeCommand(protocolData, requestBytes)
 
-		outBuffer := bytes.NewBuffer(nil)
-		err = protocol.WriteData(outBuffer, response)
-		if err != nil {
-			slog.Error("failed to write parse response error", "error", err, "request", string(requestBytes))
-		}
+		response := h.executeCommand(session, protocolData, requestBytesAsArray(protocolData, frameBytes), out, disconnected)
 
-		_, err = connection.Write(outBuffer.Bytes())
+		err := protocol.WriteDataWithVersion(out, response, session.ProtocolVersion)
 		if err != nil {
-			slog.Error("failed to send response", "error", err, "request", string(requestBytes))
+			slog.Error("failed to write parse response error", "error", err, "request", string(frameBytes))
 		}
+	}
+}
+
+// requestBytesAsArray returns the bytes of the request as a RESP array, re-encoding inline requests so that
+// only arrays are written to the append-only log.
+func requestBytesAsArray(protocolData protocol.Data, frameBytes []byte) []byte {
+	if protocol.DataTypeSymbol(frameBytes[0]) == protocol.ArraySymbol {
+		return frameBytes
+	}
 
-		copy(readBuffer, buffer.Bytes()[requestByteCount:])
-		buffer.Reset()
+	buffer := bytes.NewBuffer(nil)
+	err := protocol.WriteData(buffer, protocolData)
+	if err != nil {
+		slog.Error("failed to encode inline request", "error", err, "request", string(frameBytes))
+		return frameBytes
 	}
+	return buffer.Bytes()
 }
 
-func (h connectionHandler) executeCommand(protocolData protocol.Data, requestBytes []byte) protocol.Data {
+func (h connectionHandler) executeCommand(session *command.Session, protocolData protocol.Data, requestBytes []byte, out *bufio.Writer, disconnected <-chan struct{}) protocol.Data {
+	if session.InTransaction() {
+		// queued commands outlive the read buffer their request is in, which is reused for later reads
+		requestBytes = bytes.Clone(requestBytes)
+	}
+
 	parsedCommand, commandError := h.validator.Validate(requestBytes, protocolData)
 
 	switch {
 	case commandError != nil:
 		slog.Error("failed to parse request", "error", commandError, "request", string(requestBytes))
+		session.FailTransaction()
 		return commandError
 	case parsedCommand == nil:
 		slog.Error("expect a command if there is no error data on parsing", "error", commandError, "request", string(requestBytes))
 		return protocol.NewSimpleError("ERR protocol error")
 	default:
-		responseReceiver := make(chan protocol.Data)
-		errorReceiver := make(chan error)
+		commandData, _ := command.FromData(protocolData)
+		if contextError := session.CheckSubscribedContext(parsedCommand, commandData.Name); contextError != nil {
+			return contextError
+		}
 
-		h.executor.Execute(parsedCommand, responseReceiver, errorReceiver)
+		if response, queued := session.Queue(parsedCommand); queued {
+			return response
+		}
 
-		select {
-		case err := <-errorReceiver:
-			slog.Error("failed to execute request", "error", err, "request", string(requestBytes))
-			return protocol.NewSimpleError("ERR protocol error")
+		// messages are not written while a transaction executes, as its commands may change the session
+		messages := session.Subscriber.Ready()
+		if execCommand, ok := parsedCommand.(command.ExecCommand); ok {
+			transaction, errorData := execCommand.Transaction(session)
+			if errorData != nil {
+				return errorData
+			}
+			parsedCommand = transaction
+			messages = nil
+		}
 
-		case response := <-responseReceiver:
-			return response
+		if sessionCommand, ok := parsedCommand.(command.SessionCommand); ok {
+			return sessionCommand.ExecuteInSession(session)
+		}
+
+		// the receivers are buffered so the executor never waits on a client which disconnected while blocked
+		responseReceiver := make(chan protocol.Data, 1)
+		errorReceiver := make(chan error, 1)
+
+		var cancelled <-chan struct{}
+		if _, ok := parsedCommand.(command.BlockingCommand); ok {
+			cancelled = disconnected
+		}
+
+		h.executor.Execute(parsedCommand, session.Database, responseReceiver, errorReceiver)
+
+		for {
+			select {
+			case <-cancelled:
+				h.executor.Cancel(parsedCommand)
+				return nil
+
+			case err := <-errorReceiver:
+				slog.Error("failed to execute request", "error", err, "request", string(requestBytes))
+				return protocol.NewSimpleError("ERR protocol error")
+
+			case response := <-responseReceiver:
+				return response
+
+			case <-messages:
+				// messages are sent as they are published, even while a blocking command waits
+				writeMessages(session, out)
+				flush(out)
+			}
 		}
 	}
 }
@@ -7,15 +7,19 @@ import (
 	"log/slog"
 	"redis-challenge/internal/command"
 	"redis-challenge/internal/protocol"
+	"redis-challenge/internal/pubsub"
 	"redis-challenge/internal/store"
 )
 
 type restorer struct {
-	store     store.Store
+	databases *store.Databases
 	validator command.Validator
 }
 
 func (h restorer) RestoreFromLog(reader io.Reader) error {
+	// the log selects the database each request applies to, as a client does, but never subscribes
+	session := command.NewSession(h.databases.Count(), pubsub.NewRegistry())
+
 	var buffer bytes.Buffer
 
 	var totalReadByteCount int
@@ -26,6 +30,10 @@ readMore:
 		bytesRead, err := reader.Read(readBuffer)
 		if err != nil {
 			if err == io.EOF {
+				if session.InTransaction() {
+					// a transaction the log ends within was never completed, so none of it is applied
+					slog.Warn("discarding transaction left incomplete at the end of the log")
+				}
 				return nil
 			}
 
@@ -48,7 +56,7 @@ readMore:
 				continue readMore
 			}
 
-			err = h.executeCommand(protocolData, readBytes[offset:offset+requestByteCount])
+			err = h.executeCommand(session, protocolData, readBytes[offset:offset+requestByteCount])
 			if err != nil {
 				return err
 			}
@@ -58,7 +66,7 @@ readMore:
 	}
 }
 
-func (h restorer) executeCommand(protocolData protocol.Data, requestBytes []byte) error {
+func (h restorer) executeCommand(session *command.Session, protocolData protocol.Data, requestBytes []byte) error {
 	parsedCommand, commandError := h.validator.Validate(requestBytes, protocolData)
 
 	switch {
@@ -67,7 +75,27 @@ func (h restorer) executeCommand(protocolData protocol.Data, requestBytes []byte
 	case parsedCommand == nil:
 		return fmt.Errorf("request from log is not a command: %v %s", commandError, string(requestBytes))
 	default:
-		_, err := parsedCommand.Execute(h.store)
+		if _, queued := session.Queue(parsedCommand); queued {
+			return nil
+		}
+
+		if execCommand, ok := parsedCommand.(command.ExecCommand); ok {
+			transaction, errorData := execCommand.Transaction(session)
+			if errorData != nil {
+				return fmt.Errorf("failed to execute request from log: %v %s", errorData, string(requestBytes))
+			}
+			_, err := transaction.ExecuteTransaction(h.databases, nil)
+			return err
+		}
+
+		if sessionCommand, ok := parsedCommand.(command.SessionCommand); ok {
+			if response, ok := sessionCommand.ExecuteInSession(session).(protocol.SimpleError); ok {
+				return fmt.Errorf("failed to execute request from log: %v %s", response, string(requestBytes))
+			}
+			return nil
+		}
+
+		_, err := command.ExecuteInDatabase(parsedCommand, h.databases, session.Database)
 		if err != nil {
 			return err
 		}
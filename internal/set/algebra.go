@@ -0,0 +1,93 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Intersection returns the members found in every set, checking the members of the smallest set first.
+func Intersection(sets []*Set) *Set {
+	result := New()
+	for member := range intersectingMembers(sets) {
+		result.Add(member)
+	}
+	return result
+}
+
+// IntersectionCardinality returns the number of members found in every set, stopping once the limit is reached
+// unless the limit is zero.
+func IntersectionCardinality(sets []*Set, limit int) int {
+	var count int
+	for range intersectingMembers(sets) {
+		count++
+		if count == limit {
+			break
+		}
+	}
+	return count
+}
+
+func intersectingMembers(sets []*Set) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if len(sets) == 0 {
+			return
+		}
+
+		bySize := slices.Clone(sets)
+		slices.SortFunc(bySize, func(a, b *Set) int {
+			return cmp.Compare(a.Len(), b.Len())
+		})
+
+		smallest, others := bySize[0], bySize[1:]
+		for member := range smallest.Members() {
+			if containedInAll(others, member) && !yield(member) {
+				return
+			}
+		}
+	}
+}
+
+func containedInAll(sets []*Set, member string) bool {
+	for _, s := range sets {
+		if !s.Contains(member) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the members found in any of the sets.
+func Union(sets []*Set) *Set {
+	result := New()
+	for _, s := range sets {
+		for member := range s.Members() {
+			result.Add(member)
+		}
+	}
+	return result
+}
+
+// Difference returns the members of the first set which are not found in any of the other sets.
+func Difference(sets []*Set) *Set {
+	result := New()
+	if len(sets) == 0 {
+		return result
+	}
+
+	for member := range sets[0].Members() {
+		if !containedInAny(sets[1:], member) {
+			result.Add(member)
+		}
+	}
+	return result
+}
+
+func containedInAny(sets []*Set, member string) bool {
+	for _, s := range sets {
+		if s.Contains(member) {
+			return true
+		}
+	}
+	return false
+}
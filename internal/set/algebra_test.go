@@ -0,0 +1,67 @@
+package set_test
+
+import (
+	"redis-challenge/internal/set"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAlgebra(t *testing.T) {
+
+	newSet := func(members ...string) *set.Set {
+		s := set.New()
+		for _, member := range members {
+			s.Add(member)
+		}
+		return s
+	}
+
+	t.Run("intersection has the members in every set", func(t *testing.T) {
+		result := set.Intersection([]*set.Set{newSet("a", "b", "c", "d"), newSet("b", "c", "e"), newSet("c", "b")})
+
+		assert.ElementsMatch(t, []string{"b", "c"}, slices.Collect(result.Members()))
+	})
+
+	t.Run("intersection with a missing set is empty", func(t *testing.T) {
+		result := set.Intersection([]*set.Set{newSet("a", "b"), nil})
+
+		assert.Equal(t, 0, result.Len())
+	})
+
+	t.Run("intersection cardinality stops at the limit", func(t *testing.T) {
+		sets := []*set.Set{newSet("a", "b", "c"), newSet("a", "b", "c", "d")}
+
+		assert.Equal(t, 3, set.IntersectionCardinality(sets, 0))
+		assert.Equal(t, 2, set.IntersectionCardinality(sets, 2))
+		assert.Equal(t, 3, set.IntersectionCardinality(sets, 10))
+	})
+
+	t.Run("union has the members in any set", func(t *testing.T) {
+		result := set.Union([]*set.Set{newSet("a", "b"), nil, newSet("b", "c")})
+
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(result.Members()))
+	})
+
+	t.Run("union of a single set is a copy", func(t *testing.T) {
+		original := newSet("a")
+
+		result := set.Union([]*set.Set{original})
+		result.Add("b")
+
+		assert.Equal(t, 1, original.Len())
+	})
+
+	t.Run("difference has the members of the first set not in the others", func(t *testing.T) {
+		result := set.Difference([]*set.Set{newSet("a", "b", "c", "d"), newSet("b"), nil, newSet("d", "e")})
+
+		assert.ElementsMatch(t, []string{"a", "c"}, slices.Collect(result.Members()))
+	})
+
+	t.Run("difference with a missing first set is empty", func(t *testing.T) {
+		result := set.Difference([]*set.Set{nil, newSet("a")})
+
+		assert.Equal(t, 0, result.Len())
+	})
+}
@@ -0,0 +1,120 @@
+package set
+
+import (
+	"iter"
+	"math/rand"
+	"redis-challenge/internal/scan"
+)
+
+// Set is an unordered collection of unique members which supports picking members at random.
+type Set struct {
+	members   []string
+	indexes   map[string]int
+	scanOrder scan.Order
+}
+
+func New() *Set {
+	return &Set{indexes: make(map[string]int)}
+}
+
+func (s *Set) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.members)
+}
+
+func (s *Set) Contains(member string) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.indexes[member]
+	return ok
+}
+
+// Add adds the member and returns true if it was not already in the set.
+func (s *Set) Add(member string) bool {
+	if _, ok := s.indexes[member]; ok {
+		return false
+	}
+
+	s.indexes[member] = len(s.members)
+	s.members = append(s.members, member)
+	s.scanOrder.Reset()
+	return true
+}
+
+// Remove removes the member and returns true if it was in the set.
+func (s *Set) Remove(member string) bool {
+	index, ok := s.indexes[member]
+	if !ok {
+		return false
+	}
+
+	lastIndex := len(s.members) - 1
+	lastMember := s.members[lastIndex]
+
+	s.members[index] = lastMember
+	s.indexes[lastMember] = index
+
+	s.members = s.members[:lastIndex]
+	delete(s.indexes, member)
+	s.scanOrder.Reset()
+	return true
+}
+
+// Scan returns at least count members from the cursor onwards with the cursor to continue from, in the order of
+// their scan position.
+func (s *Set) Scan(cursor uint64, count int) (uint64, []string) {
+	if s == nil {
+		return 0, nil
+	}
+	return s.scanOrder.Page(s.Members(), cursor, count)
+}
+
+func (s *Set) Members() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if s == nil {
+			return
+		}
+		for _, member := range s.members {
+			if !yield(member) {
+				return
+			}
+		}
+	}
+}
+
+// RandomMember returns a member picked at random, which must only be called on a non-empty set.
+func (s *Set) RandomMember(random *rand.Rand) string {
+	return s.members[random.Intn(len(s.members))]
+}
+
+// RandomDistinctMembers returns up to count different members picked at random.
+func (s *Set) RandomDistinctMembers(random *rand.Rand, count int) []string {
+	if count >= s.Len() {
+		members := make([]string, s.Len())
+		copy(members, s.members)
+		return members
+	}
+
+	shuffled := make([]string, len(s.members))
+	copy(shuffled, s.members)
+	for i := range count {
+		j := i + random.Intn(len(shuffled)-i)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+	return shuffled[:count]
+}
+
+func (s *Set) Copy() *Set {
+	copied := &Set{
+		members: make([]string, len(s.members)),
+		indexes: make(map[string]int, len(s.indexes)),
+	}
+	copy(copied.members, s.members)
+	for member, index := range s.indexes {
+		copied.indexes[member] = index
+	}
+	return copied
+}
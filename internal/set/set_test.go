@@ -0,0 +1,94 @@
+package set_test
+
+import (
+	"math/rand"
+	"redis-challenge/internal/set"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+
+	newSet := func(members ...string) *set.Set {
+		s := set.New()
+		for _, member := range members {
+			s.Add(member)
+		}
+		return s
+	}
+
+	t.Run("adding a member only adds it once", func(t *testing.T) {
+		s := set.New()
+
+		assert.True(t, s.Add("a"))
+		assert.False(t, s.Add("a"))
+
+		assert.Equal(t, 1, s.Len())
+		assert.True(t, s.Contains("a"))
+	})
+
+	t.Run("removing a member keeps the other members", func(t *testing.T) {
+		s := newSet("a", "b", "c")
+
+		assert.True(t, s.Remove("a"))
+		assert.False(t, s.Remove("a"))
+
+		assert.False(t, s.Contains("a"))
+		assert.ElementsMatch(t, []string{"b", "c"}, slices.Collect(s.Members()))
+	})
+
+	t.Run("removing the last member empties the set", func(t *testing.T) {
+		s := newSet("a")
+
+		assert.True(t, s.Remove("a"))
+
+		assert.Equal(t, 0, s.Len())
+		assert.Empty(t, slices.Collect(s.Members()))
+	})
+
+	t.Run("random member is a member of the set", func(t *testing.T) {
+		s := newSet("a", "b", "c")
+
+		member := s.RandomMember(rand.New(rand.NewSource(1)))
+
+		assert.True(t, s.Contains(member))
+	})
+
+	t.Run("random distinct members are different members of the set", func(t *testing.T) {
+		s := newSet("a", "b", "c", "d", "e")
+
+		members := s.RandomDistinctMembers(rand.New(rand.NewSource(1)), 3)
+
+		assert.Len(t, members, 3)
+		assert.Equal(t, 3, newSet(members...).Len(), "members should be different")
+		for _, member := range members {
+			assert.True(t, s.Contains(member))
+		}
+	})
+
+	t.Run("random distinct members with a count beyond the size are all members", func(t *testing.T) {
+		s := newSet("a", "b")
+
+		members := s.RandomDistinctMembers(rand.New(rand.NewSource(1)), 5)
+
+		assert.ElementsMatch(t, []string{"a", "b"}, members)
+	})
+
+	t.Run("random selection with the same seed is deterministic", func(t *testing.T) {
+		s := newSet("a", "b", "c", "d", "e")
+
+		first := s.RandomDistinctMembers(rand.New(rand.NewSource(42)), 2)
+		second := s.RandomDistinctMembers(rand.New(rand.NewSource(42)), 2)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("a nil set is empty", func(t *testing.T) {
+		var s *set.Set
+
+		assert.Equal(t, 0, s.Len())
+		assert.False(t, s.Contains("a"))
+	})
+}
@@ -0,0 +1,117 @@
+package sortedset
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Aggregate is how the scores of a member found in several sorted sets are combined, following the AGGREGATE
+// option of ZUNIONSTORE and ZINTERSTORE.
+type Aggregate int
+
+const (
+	AggregateSum Aggregate = iota
+	AggregateMinimum
+	AggregateMaximum
+)
+
+func (a Aggregate) combine(current float64, score float64) float64 {
+	switch a {
+	case AggregateMinimum:
+		return min(current, score)
+	case AggregateMaximum:
+		return max(current, score)
+	default:
+		// Adding infinities of opposite sign is zero rather than NaN
+		if sum := current + score; !math.IsNaN(sum) {
+			return sum
+		}
+		return 0
+	}
+}
+
+// weighted returns the score multiplied by the weight, where zero times an infinity is zero rather than NaN.
+func weighted(score float64, weight float64) float64 {
+	if product := score * weight; !math.IsNaN(product) {
+		return product
+	}
+	return 0
+}
+
+// Union returns the members found in any of the sorted sets, where weights has a weight for each sorted set
+// which multiplies its scores before they are aggregated.
+func Union(sets []*SortedSet, weights []float64, aggregate Aggregate) *SortedSet {
+	result := New()
+	for i, s := range sets {
+		for e := range s.Entries() {
+			score := weighted(e.Score, weights[i])
+			if current, ok := result.Score(e.Member); ok {
+				score = aggregate.combine(current, score)
+			}
+			result.Add(e.Member, score)
+		}
+	}
+	return result
+}
+
+// Intersection returns the members found in every sorted set, where weights has a weight for each sorted set
+// which multiplies its scores before they are aggregated.
+func Intersection(sets []*SortedSet, weights []float64, aggregate Aggregate) *SortedSet {
+	result := New()
+	if len(sets) == 0 {
+		return result
+	}
+
+	bySize := make([]int, len(sets))
+	for i := range bySize {
+		bySize[i] = i
+	}
+	slices.SortStableFunc(bySize, func(a, b int) int {
+		return cmp.Compare(sets[a].Len(), sets[b].Len())
+	})
+
+	smallest, others := bySize[0], bySize[1:]
+	for e := range sets[smallest].Entries() {
+		score := weighted(e.Score, weights[smallest])
+		found := true
+		for _, i := range others {
+			other, ok := sets[i].Score(e.Member)
+			if !ok {
+				found = false
+				break
+			}
+			score = aggregate.combine(score, weighted(other, weights[i]))
+		}
+
+		if found {
+			result.Add(e.Member, score)
+		}
+	}
+	return result
+}
+
+// Difference returns the entries of the first sorted set whose members are not found in any of the other
+// sorted sets.
+func Difference(sets []*SortedSet) *SortedSet {
+	result := New()
+	if len(sets) == 0 {
+		return result
+	}
+
+	for e := range sets[0].Entries() {
+		if !containedInAny(sets[1:], e.Member) {
+			result.Add(e.Member, e.Score)
+		}
+	}
+	return result
+}
+
+func containedInAny(sets []*SortedSet, member string) bool {
+	for _, s := range sets {
+		if _, ok := s.Score(member); ok {
+			return true
+		}
+	}
+	return false
+}
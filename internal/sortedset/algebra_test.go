@@ -0,0 +1,81 @@
+package sortedset_test
+
+import (
+	"math"
+	"redis-challenge/internal/sortedset"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSetAlgebra(t *testing.T) {
+
+	newSortedSet := func(entries ...sortedset.Entry) *sortedset.SortedSet {
+		s := sortedset.New()
+		for _, e := range entries {
+			s.Add(e.Member, e.Score)
+		}
+		return s
+	}
+
+	a := newSortedSet(sortedset.Entry{Member: "a", Score: 1}, sortedset.Entry{Member: "b", Score: 2})
+	b := newSortedSet(sortedset.Entry{Member: "b", Score: 3}, sortedset.Entry{Member: "c", Score: 4})
+
+	t.Run("union adds the weighted scores of members in any sorted set", func(t *testing.T) {
+		result := sortedset.Union([]*sortedset.SortedSet{a, nil, b}, []float64{2, 1, 1}, sortedset.AggregateSum)
+
+		assert.Equal(t, []sortedset.Entry{
+			{Member: "a", Score: 2},
+			{Member: "c", Score: 4},
+			{Member: "b", Score: 7},
+		}, slices.Collect(result.Entries()))
+	})
+
+	t.Run("union keeps the minimum or maximum score", func(t *testing.T) {
+		minimum := sortedset.Union([]*sortedset.SortedSet{a, b}, []float64{1, 1}, sortedset.AggregateMinimum)
+		maximum := sortedset.Union([]*sortedset.SortedSet{a, b}, []float64{1, 1}, sortedset.AggregateMaximum)
+
+		score, _ := minimum.Score("b")
+		assert.Equal(t, float64(2), score)
+		score, _ = maximum.Score("b")
+		assert.Equal(t, float64(3), score)
+	})
+
+	t.Run("intersection aggregates the scores of members in every sorted set", func(t *testing.T) {
+		result := sortedset.Intersection([]*sortedset.SortedSet{a, b}, []float64{1, 10}, sortedset.AggregateSum)
+
+		assert.Equal(t, []sortedset.Entry{{Member: "b", Score: 32}}, slices.Collect(result.Entries()))
+	})
+
+	t.Run("intersection with a missing sorted set is empty", func(t *testing.T) {
+		result := sortedset.Intersection([]*sortedset.SortedSet{a, nil}, []float64{1, 1}, sortedset.AggregateSum)
+
+		assert.Equal(t, 0, result.Len())
+	})
+
+	t.Run("adding infinities of opposite sign is zero", func(t *testing.T) {
+		positive := newSortedSet(sortedset.Entry{Member: "a", Score: math.Inf(1)})
+		negative := newSortedSet(sortedset.Entry{Member: "a", Score: math.Inf(-1)})
+
+		result := sortedset.Union([]*sortedset.SortedSet{positive, negative}, []float64{1, 1}, sortedset.AggregateSum)
+
+		score, _ := result.Score("a")
+		assert.Equal(t, float64(0), score)
+	})
+
+	t.Run("a zero weight of an infinite score is zero", func(t *testing.T) {
+		infinite := newSortedSet(sortedset.Entry{Member: "a", Score: math.Inf(1)})
+
+		result := sortedset.Union([]*sortedset.SortedSet{infinite}, []float64{0}, sortedset.AggregateSum)
+
+		score, _ := result.Score("a")
+		assert.Equal(t, float64(0), score)
+	})
+
+	t.Run("difference keeps the entries of the first sorted set not in the others", func(t *testing.T) {
+		result := sortedset.Difference([]*sortedset.SortedSet{a, nil, b})
+
+		assert.Equal(t, []sortedset.Entry{{Member: "a", Score: 1}}, slices.Collect(result.Entries()))
+	})
+}
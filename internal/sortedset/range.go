@@ -0,0 +1,146 @@
+package sortedset
+
+import "math"
+
+// Range selects the entries between a start and an end, assuming entries before the start are never after the end.
+type Range interface {
+	// startsBefore returns true if the range starts at or before the entry.
+	startsBefore(score float64, member string) bool
+	// endsAfter returns true if the range ends at or after the entry.
+	endsAfter(score float64, member string) bool
+}
+
+type ScoreBound struct {
+	Score     float64
+	Exclusive bool
+}
+
+// ScoreRange selects entries by score, as in ZRANGE BYSCORE.
+type ScoreRange struct {
+	Minimum ScoreBound
+	Maximum ScoreBound
+}
+
+func (r ScoreRange) startsBefore(score float64, _ string) bool {
+	if r.Minimum.Exclusive {
+		return r.Minimum.Score < score
+	}
+	return r.Minimum.Score <= score
+}
+
+func (r ScoreRange) endsAfter(score float64, _ string) bool {
+	if r.Maximum.Exclusive {
+		return score < r.Maximum.Score
+	}
+	return score <= r.Maximum.Score
+}
+
+// LexBound is a bound on members, where an infinite bound of -1 is before every member and of +1 is after every
+// member.
+type LexBound struct {
+	Member    string
+	Exclusive bool
+	Infinite  int
+}
+
+// LexRange selects entries by member, as in ZRANGE BYLEX, which is only meaningful when every score is the same.
+type LexRange struct {
+	Minimum LexBound
+	Maximum LexBound
+}
+
+func (r LexRange) startsBefore(_ float64, member string) bool {
+	switch {
+	case r.Minimum.Infinite != 0:
+		return r.Minimum.Infinite < 0
+	case r.Minimum.Exclusive:
+		return r.Minimum.Member < member
+	default:
+		return r.Minimum.Member <= member
+	}
+}
+
+func (r LexRange) endsAfter(_ float64, member string) bool {
+	switch {
+	case r.Maximum.Infinite != 0:
+		return r.Maximum.Infinite > 0
+	case r.Maximum.Exclusive:
+		return member < r.Maximum.Member
+	default:
+		return member <= r.Maximum.Member
+	}
+}
+
+// EntriesInRankRange returns the entries from the start to the stop rank inclusive, where negative ranks count
+// back from the last entry, in reverse order from the highest score if reversed.
+func (s *SortedSet) EntriesInRankRange(start int, stop int, reverse bool) []Entry {
+	length := s.Len()
+	if start < 0 {
+		start = max(length+start, 0)
+	}
+	if stop < 0 {
+		stop = length + stop
+	}
+	stop = min(stop, length-1)
+	if start > stop {
+		return nil
+	}
+
+	entries := make([]Entry, 0, stop-start+1)
+	if reverse {
+		for x := s.ordered.byRank(length - start); x != nil && len(entries) < cap(entries); x = x.backward {
+			entries = append(entries, Entry{Member: x.member, Score: x.score})
+		}
+	} else {
+		for x := s.ordered.byRank(start + 1); x != nil && len(entries) < cap(entries); x = x.levels[0].forward {
+			entries = append(entries, Entry{Member: x.member, Score: x.score})
+		}
+	}
+	return entries
+}
+
+// EntriesInRange returns the entries in the range, in reverse order from the end of the range if reversed. The
+// offset skips entries from the beginning and a negative count returns every remaining entry.
+func (s *SortedSet) EntriesInRange(r Range, reverse bool, offset int, count int) []Entry {
+	if s == nil || offset < 0 {
+		return nil
+	}
+	if count < 0 {
+		count = math.MaxInt
+	}
+
+	var entries []Entry
+	if reverse {
+		for x := s.ordered.last(r); x != nil && len(entries) < count && r.startsBefore(x.score, x.member); x = x.backward {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			entries = append(entries, Entry{Member: x.member, Score: x.score})
+		}
+	} else {
+		for x := s.ordered.first(r); x != nil && len(entries) < count && r.endsAfter(x.score, x.member); x = x.levels[0].forward {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			entries = append(entries, Entry{Member: x.member, Score: x.score})
+		}
+	}
+	return entries
+}
+
+// CountInRange returns the number of entries in the range.
+func (s *SortedSet) CountInRange(r Range) int {
+	if s == nil {
+		return 0
+	}
+
+	first := s.ordered.first(r)
+	if first == nil {
+		return 0
+	}
+	last := s.ordered.last(r)
+
+	return s.ordered.rank(last.member, last.score) - s.ordered.rank(first.member, first.score) + 1
+}
@@ -0,0 +1,166 @@
+package sortedset_test
+
+import (
+	"math"
+	"redis-challenge/internal/sortedset"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSetRanges(t *testing.T) {
+
+	scored := func() *sortedset.SortedSet {
+		s := sortedset.New()
+		for i, member := range []string{"a", "b", "c", "d", "e"} {
+			s.Add(member, float64(i+1))
+		}
+		return s
+	}
+
+	lexical := func() *sortedset.SortedSet {
+		s := sortedset.New()
+		for _, member := range []string{"a", "b", "c", "d", "e"} {
+			s.Add(member, 0)
+		}
+		return s
+	}
+
+	membersOf := func(entries []sortedset.Entry) []string {
+		members := make([]string, len(entries))
+		for i, entry := range entries {
+			members[i] = entry.Member
+		}
+		return members
+	}
+
+	inclusive := func(score float64) sortedset.ScoreBound {
+		return sortedset.ScoreBound{Score: score}
+	}
+	exclusive := func(score float64) sortedset.ScoreBound {
+		return sortedset.ScoreBound{Score: score, Exclusive: true}
+	}
+
+	t.Run("rank ranges", func(t *testing.T) {
+		testCases := map[string]struct {
+			start, stop int
+			reverse     bool
+			expected    []string
+		}{
+			"whole set":                          {start: 0, stop: -1, expected: []string{"a", "b", "c", "d", "e"}},
+			"middle of the set":                  {start: 1, stop: 2, expected: []string{"b", "c"}},
+			"negative indexes from the end":      {start: -2, stop: -1, expected: []string{"d", "e"}},
+			"stop beyond the end is the end":     {start: 3, stop: 100, expected: []string{"d", "e"}},
+			"start before the beginning is zero": {start: -100, stop: 0, expected: []string{"a"}},
+			"start after stop is empty":          {start: 3, stop: 1, expected: []string{}},
+			"start beyond the end is empty":      {start: 5, stop: 10, expected: []string{}},
+			"reversed from the highest score":    {start: 0, stop: 1, reverse: true, expected: []string{"e", "d"}},
+			"reversed negative indexes":          {start: -2, stop: -1, reverse: true, expected: []string{"b", "a"}},
+		}
+
+		for name, testCase := range testCases {
+			t.Run(name, func(t *testing.T) {
+				entries := scored().EntriesInRankRange(testCase.start, testCase.stop, testCase.reverse)
+
+				assert.Equal(t, testCase.expected, membersOf(entries))
+			})
+		}
+	})
+
+	t.Run("score ranges", func(t *testing.T) {
+		testCases := map[string]struct {
+			r        sortedset.ScoreRange
+			reverse  bool
+			offset   int
+			count    int
+			expected []string
+		}{
+			"inclusive bounds": {
+				r: sortedset.ScoreRange{Minimum: inclusive(2), Maximum: inclusive(4)}, count: -1, expected: []string{"b", "c", "d"},
+			},
+			"exclusive bounds": {
+				r: sortedset.ScoreRange{Minimum: exclusive(2), Maximum: exclusive(4)}, count: -1, expected: []string{"c"},
+			},
+			"infinite bounds": {
+				r: sortedset.ScoreRange{Minimum: inclusive(math.Inf(-1)), Maximum: inclusive(math.Inf(1))}, count: -1, expected: []string{"a", "b", "c", "d", "e"},
+			},
+			"minimum after maximum is empty": {
+				r: sortedset.ScoreRange{Minimum: inclusive(4), Maximum: inclusive(2)}, count: -1, expected: []string{},
+			},
+			"equal exclusive bounds is empty": {
+				r: sortedset.ScoreRange{Minimum: exclusive(3), Maximum: inclusive(3)}, count: -1, expected: []string{},
+			},
+			"offset and count": {
+				r: sortedset.ScoreRange{Minimum: inclusive(1), Maximum: inclusive(5)}, offset: 1, count: 2, expected: []string{"b", "c"},
+			},
+			"negative offset is empty": {
+				r: sortedset.ScoreRange{Minimum: inclusive(1), Maximum: inclusive(5)}, offset: -1, count: 2, expected: []string{},
+			},
+			"reversed from the maximum": {
+				r: sortedset.ScoreRange{Minimum: inclusive(2), Maximum: exclusive(5)}, reverse: true, offset: 1, count: -1, expected: []string{"c", "b"},
+			},
+		}
+
+		for name, testCase := range testCases {
+			t.Run(name, func(t *testing.T) {
+				entries := scored().EntriesInRange(testCase.r, testCase.reverse, testCase.offset, testCase.count)
+
+				assert.Equal(t, testCase.expected, membersOf(entries))
+				if testCase.offset == 0 && testCase.count < 0 {
+					assert.Equal(t, len(testCase.expected), scored().CountInRange(testCase.r))
+				}
+			})
+		}
+	})
+
+	t.Run("lex ranges", func(t *testing.T) {
+		testCases := map[string]struct {
+			r        sortedset.LexRange
+			reverse  bool
+			expected []string
+		}{
+			"inclusive bounds": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Member: "b"}, Maximum: sortedset.LexBound{Member: "d"}},
+				expected: []string{"b", "c", "d"},
+			},
+			"exclusive bounds": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Member: "b", Exclusive: true}, Maximum: sortedset.LexBound{Member: "d", Exclusive: true}},
+				expected: []string{"c"},
+			},
+			"infinite bounds": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Infinite: -1}, Maximum: sortedset.LexBound{Infinite: 1}},
+				expected: []string{"a", "b", "c", "d", "e"},
+			},
+			"bounds between members": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Member: "bb"}, Maximum: sortedset.LexBound{Member: "dd"}},
+				expected: []string{"c", "d"},
+			},
+			"maximum of minus infinity is empty": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Infinite: -1}, Maximum: sortedset.LexBound{Infinite: -1}},
+				expected: []string{},
+			},
+			"reversed from the maximum": {
+				r:        sortedset.LexRange{Minimum: sortedset.LexBound{Member: "c"}, Maximum: sortedset.LexBound{Infinite: 1}},
+				reverse:  true,
+				expected: []string{"e", "d", "c"},
+			},
+		}
+
+		for name, testCase := range testCases {
+			t.Run(name, func(t *testing.T) {
+				entries := lexical().EntriesInRange(testCase.r, testCase.reverse, 0, -1)
+
+				assert.Equal(t, testCase.expected, membersOf(entries))
+				assert.Equal(t, len(testCase.expected), lexical().CountInRange(testCase.r))
+			})
+		}
+	})
+
+	t.Run("ranges of a missing sorted set are empty", func(t *testing.T) {
+		var s *sortedset.SortedSet
+
+		assert.Empty(t, s.EntriesInRankRange(0, -1, false))
+		assert.Empty(t, s.EntriesInRange(sortedset.ScoreRange{Minimum: inclusive(0), Maximum: inclusive(1)}, false, 0, -1))
+		assert.Equal(t, 0, s.CountInRange(sortedset.ScoreRange{Minimum: inclusive(0), Maximum: inclusive(1)}))
+	})
+}
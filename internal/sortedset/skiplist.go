@@ -0,0 +1,206 @@
+package sortedset
+
+import "math/rand"
+
+const (
+	maximumLevel     = 32
+	levelProbability = 0.25
+)
+
+// skipList keeps entries ordered by score then member, where each level records the number of entries it spans
+// so the rank of an entry can be found in O(log n).
+type skipList struct {
+	header *node
+	tail   *node
+	length int
+	level  int
+}
+
+type node struct {
+	member   string
+	score    float64
+	backward *node
+	levels   []level
+}
+
+type level struct {
+	forward *node
+	span    int
+}
+
+func newSkipList() *skipList {
+	return &skipList{
+		header: &node{levels: make([]level, maximumLevel)},
+		level:  1,
+	}
+}
+
+// before returns true if the node is ordered before the given score and member.
+func (n *node) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+// after returns true if the node is ordered after the given score and member.
+func (n *node) after(score float64, member string) bool {
+	return n.score > score || (n.score == score && n.member > member)
+}
+
+func randomLevel() int {
+	lvl := 1
+	for lvl < maximumLevel && rand.Float64() < levelProbability {
+		lvl++
+	}
+	return lvl
+}
+
+func (l *skipList) insert(member string, score float64) {
+	var update [maximumLevel]*node
+	var rank [maximumLevel]int
+
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			rank[i] += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	lvl := randomLevel()
+	if lvl > l.level {
+		for i := l.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = l.header
+			update[i].levels[i].span = l.length
+		}
+		l.level = lvl
+	}
+
+	x = &node{member: member, score: score, levels: make([]level, lvl)}
+	for i := range lvl {
+		x.levels[i].forward = update[i].levels[i].forward
+		update[i].levels[i].forward = x
+
+		x.levels[i].span = update[i].levels[i].span - (rank[0] - rank[i])
+		update[i].levels[i].span = rank[0] - rank[i] + 1
+	}
+	for i := lvl; i < l.level; i++ {
+		update[i].levels[i].span++
+	}
+
+	if update[0] != l.header {
+		x.backward = update[0]
+	}
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x
+	} else {
+		l.tail = x
+	}
+	l.length++
+}
+
+func (l *skipList) delete(member string, score float64) bool {
+	var update [maximumLevel]*node
+
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && x.levels[i].forward.before(score, member) {
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+
+	x = x.levels[0].forward
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+
+	for i := range l.level {
+		if update[i].levels[i].forward == x {
+			update[i].levels[i].span += x.levels[i].span - 1
+			update[i].levels[i].forward = x.levels[i].forward
+		} else {
+			update[i].levels[i].span--
+		}
+	}
+
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x.backward
+	} else {
+		l.tail = x.backward
+	}
+
+	for l.level > 1 && l.header.levels[l.level-1].forward == nil {
+		l.level--
+	}
+	l.length--
+	return true
+}
+
+// rank returns the 1-based rank of the member with the score, or 0 if it is not in the list.
+func (l *skipList) rank(member string, score float64) int {
+	var rank int
+
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && !x.levels[i].forward.after(score, member) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		if x != l.header && x.member == member {
+			return rank
+		}
+	}
+	return 0
+}
+
+// byRank returns the node at the 1-based rank, or nil if the rank is out of range.
+func (l *skipList) byRank(rank int) *node {
+	var traversed int
+
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && traversed+x.levels[i].span <= rank {
+			traversed += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		if traversed == rank && x != l.header {
+			return x
+		}
+	}
+	return nil
+}
+
+// first returns the first node in the range, or nil if no node is in the range.
+func (l *skipList) first(r Range) *node {
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && !r.startsBefore(x.levels[i].forward.score, x.levels[i].forward.member) {
+			x = x.levels[i].forward
+		}
+	}
+
+	x = x.levels[0].forward
+	if x == nil || !r.endsAfter(x.score, x.member) {
+		return nil
+	}
+	return x
+}
+
+// last returns the last node in the range, or nil if no node is in the range.
+func (l *skipList) last(r Range) *node {
+	x := l.header
+	for i := l.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && r.endsAfter(x.levels[i].forward.score, x.levels[i].forward.member) {
+			x = x.levels[i].forward
+		}
+	}
+
+	if x == l.header || !r.startsBefore(x.score, x.member) {
+		return nil
+	}
+	return x
+}
@@ -0,0 +1,161 @@
+package sortedset
+
+import (
+	"iter"
+	"maps"
+	"math/rand"
+	"redis-challenge/internal/scan"
+	"slices"
+)
+
+type Entry struct {
+	Member string
+	Score  float64
+}
+
+// SortedSet keeps members ordered by their score, with members of equal score ordered lexicographically.
+type SortedSet struct {
+	scores    map[string]float64
+	ordered   *skipList
+	scanOrder scan.Order
+}
+
+func New() *SortedSet {
+	return &SortedSet{
+		scores:  make(map[string]float64),
+		ordered: newSkipList(),
+	}
+}
+
+func (s *SortedSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.scores)
+}
+
+func (s *SortedSet) Score(member string) (float64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	score, ok := s.scores[member]
+	return score, ok
+}
+
+// Add sets the score of the member and returns true if the member was not already in the set.
+func (s *SortedSet) Add(member string, score float64) bool {
+	current, exists := s.scores[member]
+	if exists {
+		if current == score {
+			return false
+		}
+		s.ordered.delete(member, current)
+	}
+
+	s.scores[member] = score
+	s.ordered.insert(member, score)
+	if !exists {
+		s.scanOrder.Reset()
+	}
+	return !exists
+}
+
+// Remove removes the member and returns true if it was in the set.
+func (s *SortedSet) Remove(member string) bool {
+	score, ok := s.scores[member]
+	if !ok {
+		return false
+	}
+
+	delete(s.scores, member)
+	s.ordered.delete(member, score)
+	s.scanOrder.Reset()
+	return true
+}
+
+// Scan returns at least count members from the cursor onwards with the cursor to continue from, in the order of
+// their scan position rather than their score.
+func (s *SortedSet) Scan(cursor uint64, count int) (uint64, []string) {
+	if s == nil {
+		return 0, nil
+	}
+	return s.scanOrder.Page(maps.Keys(s.scores), cursor, count)
+}
+
+// Rank returns the 0-based position of the member when ordered from the lowest score.
+func (s *SortedSet) Rank(member string) (int, bool) {
+	score, ok := s.Score(member)
+	if !ok {
+		return 0, false
+	}
+	return s.ordered.rank(member, score) - 1, true
+}
+
+// Entries returns the entries ordered from the lowest score.
+func (s *SortedSet) Entries() iter.Seq[Entry] {
+	return func(yield func(Entry) bool) {
+		if s == nil {
+			return
+		}
+		for x := s.ordered.header.levels[0].forward; x != nil; x = x.levels[0].forward {
+			if !yield(Entry{Member: x.member, Score: x.score}) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SortedSet) Copy() *SortedSet {
+	copied := New()
+	for entry := range s.Entries() {
+		copied.Add(entry.Member, entry.Score)
+	}
+	return copied
+}
+
+// RandomEntry returns an entry picked at random, which must only be called on a non-empty sorted set.
+func (s *SortedSet) RandomEntry(random *rand.Rand) Entry {
+	x := s.ordered.byRank(random.Intn(s.Len()) + 1)
+	return Entry{Member: x.member, Score: x.score}
+}
+
+// RandomDistinctEntries returns up to count entries for different members picked at random.
+func (s *SortedSet) RandomDistinctEntries(random *rand.Rand, count int) []Entry {
+	if count >= s.Len() {
+		return slices.Collect(s.Entries())
+	}
+
+	entries := make([]Entry, count)
+	for i, rank := range random.Perm(s.Len())[:count] {
+		x := s.ordered.byRank(rank + 1)
+		entries[i] = Entry{Member: x.member, Score: x.score}
+	}
+	return entries
+}
+
+// Condition restricts when the score of a member can be set, following the NX, XX, GT and LT options of ZADD.
+type Condition struct {
+	OnlyMissing  bool
+	OnlyExisting bool
+	OnlyGreater  bool
+	OnlyLess     bool
+}
+
+// Allows returns true if the member can be given the new score, where the current score is only used if the
+// member exists.
+func (c Condition) Allows(exists bool, current float64, score float64) bool {
+	if !exists {
+		return !c.OnlyExisting
+	}
+
+	switch {
+	case c.OnlyMissing:
+		return false
+	case c.OnlyGreater:
+		return score > current
+	case c.OnlyLess:
+		return score < current
+	default:
+		return true
+	}
+}
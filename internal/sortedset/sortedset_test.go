@@ -0,0 +1,209 @@
+package sortedset_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"redis-challenge/internal/sortedset"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedSet(t *testing.T) {
+
+	members := func(s *sortedset.SortedSet) []string {
+		var result []string
+		for entry := range s.Entries() {
+			result = append(result, entry.Member)
+		}
+		return result
+	}
+
+	t.Run("entries are ordered by score", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("c", 3)
+		s.Add("a", 1)
+		s.Add("b", 2)
+
+		assert.Equal(t, []string{"a", "b", "c"}, members(s))
+	})
+
+	t.Run("entries with the same score are ordered by member", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("b", 1)
+		s.Add("c", 1)
+		s.Add("a", 1)
+
+		assert.Equal(t, []string{"a", "b", "c"}, members(s))
+	})
+
+	t.Run("adding an existing member updates its score and position", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 1)
+		s.Add("b", 2)
+
+		assert.False(t, s.Add("a", 3))
+
+		score, ok := s.Score("a")
+		assert.True(t, ok)
+		assert.Equal(t, 3.0, score)
+		assert.Equal(t, []string{"b", "a"}, members(s))
+		assert.Equal(t, 2, s.Len())
+	})
+
+	t.Run("removing a member removes its entry", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 1)
+		s.Add("b", 2)
+
+		assert.True(t, s.Remove("a"))
+		assert.False(t, s.Remove("a"))
+
+		_, ok := s.Score("a")
+		assert.False(t, ok)
+		assert.Equal(t, []string{"b"}, members(s))
+	})
+
+	t.Run("infinite scores are ordered at the ends", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 0)
+		s.Add("max", math.Inf(1))
+		s.Add("min", math.Inf(-1))
+
+		assert.Equal(t, []string{"min", "a", "max"}, members(s))
+	})
+
+	t.Run("rank of a missing member is not found", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 1)
+
+		_, ok := s.Rank("b")
+		assert.False(t, ok)
+
+		var empty *sortedset.SortedSet
+		_, ok = empty.Rank("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("rank matches the order of entries after many changes", func(t *testing.T) {
+		random := rand.New(rand.NewSource(1))
+		s := sortedset.New()
+		scores := make(map[string]float64)
+
+		for i := range 2_000 {
+			member := fmt.Sprintf("m%d", random.Intn(500))
+			if i%3 == 0 {
+				s.Remove(member)
+				delete(scores, member)
+			} else {
+				score := float64(random.Intn(100))
+				s.Add(member, score)
+				scores[member] = score
+			}
+		}
+
+		expected := make([]string, 0, len(scores))
+		for member := range scores {
+			expected = append(expected, member)
+		}
+		sort.Slice(expected, func(i, j int) bool {
+			a, b := expected[i], expected[j]
+			return scores[a] < scores[b] || (scores[a] == scores[b] && a < b)
+		})
+
+		require.Equal(t, expected, members(s))
+		assert.Equal(t, len(expected), s.Len())
+		for i, member := range expected {
+			rank, ok := s.Rank(member)
+			require.True(t, ok)
+			require.Equal(t, i, rank, "rank of %s", member)
+		}
+	})
+
+	t.Run("random entry is an entry of the sorted set", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 1)
+		s.Add("b", 2)
+
+		entry := s.RandomEntry(rand.New(rand.NewSource(1)))
+
+		score, ok := s.Score(entry.Member)
+		assert.True(t, ok)
+		assert.Equal(t, score, entry.Score)
+	})
+
+	t.Run("random distinct entries are different entries of the sorted set", func(t *testing.T) {
+		s := sortedset.New()
+		for i, member := range []string{"a", "b", "c", "d", "e"} {
+			s.Add(member, float64(i))
+		}
+
+		entries := s.RandomDistinctEntries(rand.New(rand.NewSource(1)), 3)
+
+		require.Len(t, entries, 3)
+		distinct := make(map[string]bool)
+		for _, entry := range entries {
+			score, ok := s.Score(entry.Member)
+			assert.True(t, ok)
+			assert.Equal(t, score, entry.Score)
+			distinct[entry.Member] = true
+		}
+		assert.Len(t, distinct, 3, "members should be different")
+	})
+
+	t.Run("random distinct entries with a count beyond the size are all entries in order", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("b", 2)
+		s.Add("a", 1)
+
+		entries := s.RandomDistinctEntries(rand.New(rand.NewSource(1)), 5)
+
+		assert.Equal(t, []sortedset.Entry{{Member: "a", Score: 1}, {Member: "b", Score: 2}}, entries)
+	})
+
+	t.Run("condition restricts setting scores", func(t *testing.T) {
+		testCases := map[string]struct {
+			condition sortedset.Condition
+			exists    bool
+			score     float64
+			allowed   bool
+		}{
+			"no condition allows a missing member":       {condition: sortedset.Condition{}, exists: false, score: 1, allowed: true},
+			"no condition allows an existing member":     {condition: sortedset.Condition{}, exists: true, score: 1, allowed: true},
+			"only missing disallows an existing member":  {condition: sortedset.Condition{OnlyMissing: true}, exists: true, score: 3, allowed: false},
+			"only existing disallows a missing member":   {condition: sortedset.Condition{OnlyExisting: true}, exists: false, score: 3, allowed: false},
+			"only greater allows a missing member":       {condition: sortedset.Condition{OnlyGreater: true}, exists: false, score: 1, allowed: true},
+			"only greater allows a greater score":        {condition: sortedset.Condition{OnlyGreater: true}, exists: true, score: 3, allowed: true},
+			"only greater disallows an equal score":      {condition: sortedset.Condition{OnlyGreater: true}, exists: true, score: 2, allowed: false},
+			"only less allows a lower score":             {condition: sortedset.Condition{OnlyLess: true}, exists: true, score: 1, allowed: true},
+			"only less disallows a greater score":        {condition: sortedset.Condition{OnlyLess: true}, exists: true, score: 3, allowed: false},
+			"only existing and greater allows a greater": {condition: sortedset.Condition{OnlyExisting: true, OnlyGreater: true}, exists: true, score: 3, allowed: true},
+		}
+
+		for name, testCase := range testCases {
+			t.Run(name, func(t *testing.T) {
+				allowed := testCase.condition.Allows(testCase.exists, 2, testCase.score)
+
+				assert.Equal(t, testCase.allowed, allowed)
+			})
+		}
+	})
+
+	t.Run("copy does not share members with the original", func(t *testing.T) {
+		s := sortedset.New()
+		s.Add("a", 1)
+		s.Add("b", 2)
+
+		copied := s.Copy()
+		copied.Add("a", 3)
+		copied.Remove("b")
+
+		assert.Equal(t, []string{"a", "b"}, members(s))
+		score, _ := s.Score("a")
+		assert.Equal(t, 1.0, score)
+		assert.Equal(t, []string{"a"}, members(copied))
+	})
+}
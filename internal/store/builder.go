@@ -1,14 +1,20 @@
 package store
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 type Builder struct {
 	clock            Clock
 	commandLogWriter io.Writer
+	randomSeed       int64
+	databaseCount    int
+	publisher        Publisher
 }
 
 func NewBuilder() Builder {
-	return Builder{clock: SystemClock{}}
+	return Builder{clock: SystemClock{}, randomSeed: time.Now().UnixNano(), databaseCount: DefaultDatabaseCount}
 }
 
 func (b Builder) WithClock(c Clock) Builder {
@@ -21,11 +27,37 @@ func (b Builder) WithCommandLogWriter(writer io.Writer) Builder {
 	return b
 }
 
-func (b Builder) Build() (Store, *ExpiryScanner) {
-	tracker := NewExpiryTracker().withDeleteListener(&deleteListener{writer: b.commandLogWriter})
-	dataStore := New().WithClock(b.clock).WithExpiryTracker(tracker)
+func (b Builder) WithRandomSeed(seed int64) Builder {
+	b.randomSeed = seed
+	return b
+}
+
+// WithDatabaseCount sets the number of databases, which clients choose between with SELECT.
+func (b Builder) WithDatabaseCount(count int) Builder {
+	b.databaseCount = count
+	return b
+}
+
+// WithKeyspacePublisher sets where keyspace notifications are published, once they are selected with the
+// notify-keyspace-events setting.
+func (b Builder) WithKeyspacePublisher(publisher Publisher) Builder {
+	b.publisher = publisher
+	return b
+}
+
+// Build returns the databases, each with its own expiry tracker logging deletes to the command log and notifying
+// the events changing its keys.
+func (b Builder) Build() *Databases {
+	databases := &Databases{log: NewCommandLog(b.commandLogWriter), events: NewKeyspaceEvents(b.publisher)}
+
+	for i := range b.databaseCount {
+		tracker := NewExpiryTracker().withDeleteListener(&deleteListener{log: databases.log, database: i})
+		dataStore := New().WithClock(b.clock).WithExpiryTracker(tracker).WithRandomSeed(b.randomSeed + int64(i)).
+			withNotifier(&keyspaceNotifier{events: databases.events, database: i})
 
-	scanner := NewExpiryScanner(tracker, dataStore)
+		databases.stores = append(databases.stores, dataStore)
+		databases.scanners = append(databases.scanners, NewExpiryScanner(tracker, dataStore))
+	}
 
-	return dataStore, scanner
+	return databases
 }
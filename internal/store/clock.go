@@ -1,6 +1,9 @@
 package store
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type Clock interface {
 	Now() int64
@@ -12,20 +15,22 @@ func (c SystemClock) Now() int64 {
 	return time.Now().UTC().UnixMilli()
 }
 
+// FixedClock is a clock that only moves when it is advanced, which is safe to advance while the executor reads it
+// from another goroutine. The time is read and changed atomically once the clock is in use.
 type FixedClock struct {
 	TimeInMilliseconds int64
 }
 
 func (c *FixedClock) Now() int64 {
-	return c.TimeInMilliseconds
+	return atomic.LoadInt64(&c.TimeInMilliseconds)
 }
 
 func (c *FixedClock) AddSeconds(delta int64) *FixedClock {
-	c.TimeInMilliseconds += delta * 1000
+	atomic.AddInt64(&c.TimeInMilliseconds, delta*1000)
 	return c
 }
 
 func (c *FixedClock) AddMilliseconds(delta int64) *FixedClock {
-	c.TimeInMilliseconds += delta
+	atomic.AddInt64(&c.TimeInMilliseconds, delta)
 	return c
 }
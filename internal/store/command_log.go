@@ -0,0 +1,142 @@
+package store
+
+import (
+	"bytes"
+	"io"
+	"redis-challenge/internal/protocol"
+	"strconv"
+)
+
+// CommandLog writes requests to the append-only log, preceding a request with SELECT whenever it applies to a
+// different database from the request before it, so the log replays each request into the right database.
+type CommandLog struct {
+	writer   io.Writer
+	selected int
+	// transaction holds the requests written since a transaction began, or is nil outside a transaction.
+	transaction []loggedRequest
+	// command holds the requests written while a command executes, or is nil when no command is executing.
+	command []loggedRequest
+}
+
+// loggedRequest is a request of a transaction waiting to be written to the log, along with the database it
+// applies to.
+type loggedRequest struct {
+	database int
+	request  []byte
+}
+
+// NewCommandLog returns a log with no database selected, so the first request always selects its database as the
+// log may be appended to one that ended in another database.
+func NewCommandLog(writer io.Writer) *CommandLog {
+	return &CommandLog{writer: writer, selected: -1}
+}
+
+// Write writes the request to the log, selecting its database first if another database was selected.
+func (l *CommandLog) Write(database int, request []byte) error {
+	if l == nil || l.writer == nil {
+		return nil
+	}
+
+	if l.transaction != nil {
+		l.transaction = append(l.transaction, loggedRequest{database: database, request: bytes.Clone(request)})
+		return nil
+	}
+	if l.command != nil {
+		l.command = append(l.command, loggedRequest{database: database, request: bytes.Clone(request)})
+		return nil
+	}
+
+	if database != l.selected {
+		if err := protocol.WriteData(l.writer, encodeLogRequest("SELECT", strconv.Itoa(database))); err != nil {
+			return err
+		}
+		l.selected = database
+	}
+
+	_, err := l.writer.Write(request)
+	return err
+}
+
+// BeginCommand holds back the deletes of keys found expired while a command executes until the command ends, as the
+// request of the command is only known once it has executed.
+func (l *CommandLog) BeginCommand() {
+	if l != nil {
+		l.command = []loggedRequest{}
+	}
+}
+
+// EndCommand writes the deletes held back while the command executed followed by its request, if it has one, as the
+// keys expired before the command acted on them.
+func (l *CommandLog) EndCommand(database int, request []byte) error {
+	if l == nil {
+		return nil
+	}
+
+	requests := l.command
+	l.command = nil
+	for _, r := range requests {
+		if err := l.Write(r.database, r.request); err != nil {
+			return err
+		}
+	}
+	if len(request) == 0 {
+		return nil
+	}
+	return l.Write(database, request)
+}
+
+// BeginTransaction holds back the requests written until the transaction ends, including the deletes of keys found
+// expired while it executes, so that they are written together.
+func (l *CommandLog) BeginTransaction() {
+	if l != nil {
+		l.transaction = []loggedRequest{}
+	}
+}
+
+// EndTransaction writes the requests of the transaction to the log as a single block wrapped in MULTI and EXEC,
+// selecting databases within the block, so that the log replays all of the requests or none of them. Nothing is
+// written for a transaction without requests.
+func (l *CommandLog) EndTransaction() error {
+	if l == nil {
+		return nil
+	}
+
+	requests := l.transaction
+	l.transaction = nil
+	if l.writer == nil || len(requests) == 0 {
+		return nil
+	}
+
+	block := bytes.NewBuffer(nil)
+	selected := l.selected
+	if err := protocol.WriteData(block, encodeLogRequest("MULTI")); err != nil {
+		return err
+	}
+	for _, r := range requests {
+		if r.database != selected {
+			if err := protocol.WriteData(block, encodeLogRequest("SELECT", strconv.Itoa(r.database))); err != nil {
+				return err
+			}
+			selected = r.database
+		}
+		block.Write(r.request)
+	}
+	if err := protocol.WriteData(block, encodeLogRequest("EXEC")); err != nil {
+		return err
+	}
+
+	if _, err := l.writer.Write(block.Bytes()); err != nil {
+		return err
+	}
+	l.selected = selected
+	return nil
+}
+
+// encodeLogRequest returns a request the log writes itself, such as to select a database.
+func encodeLogRequest(arguments ...string) protocol.Array {
+	data := make([]protocol.Data, len(arguments))
+	for i, argument := range arguments {
+		data[i] = protocol.BulkString(argument)
+	}
+	return protocol.Array{Data: data}
+}
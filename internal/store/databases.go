@@ -0,0 +1,79 @@
+package store
+
+// DefaultDatabaseCount is the number of databases unless the builder is given another count, as in Redis.
+const DefaultDatabaseCount = 16
+
+// Databases holds the numbered databases of a server, each a store with its own expiry tracker, with the command log
+// that records which database each request applied to and the keyspace events notified by every database.
+type Databases struct {
+	stores   []*InMemoryStore
+	scanners []*ExpiryScanner
+	log      *CommandLog
+	events   *KeyspaceEvents
+}
+
+// Count returns the number of databases, which are numbered from zero.
+func (d *Databases) Count() int {
+	return len(d.stores)
+}
+
+// Store returns the database with the index, which must be in range.
+func (d *Databases) Store(index int) Store {
+	return d.stores[index]
+}
+
+// Log returns the command log that requests executed against the databases are written to.
+func (d *Databases) Log() *CommandLog {
+	return d.log
+}
+
+// KeyspaceEvents returns the keyspace events of the databases, which select the events that are notified.
+func (d *Databases) KeyspaceEvents() *KeyspaceEvents {
+	return d.events
+}
+
+// Scan removes expired keys from every database.
+func (d *Databases) Scan() {
+	for _, scanner := range d.scanners {
+		scanner.Scan()
+	}
+}
+
+// Swap swaps the keys of two databases, so clients with either database selected see the keys of the other.
+func (d *Databases) Swap(first int, second int) {
+	if first != second {
+		d.stores[first].swapKeys(d.stores[second])
+	}
+}
+
+// FlushAll removes every key from every database.
+func (d *Databases) FlushAll() {
+	for _, s := range d.stores {
+		s.Flush()
+	}
+}
+
+// Move moves the value and expiry of the key to another database, returning false if the key does not exist or
+// already exists in the other database.
+func (d *Databases) Move(key string, from int, to int) bool {
+	source, destination := d.stores[from], d.stores[to]
+
+	e, ok := source.readEntry(key)
+	if !ok || destination.Exists(key) {
+		return false
+	}
+
+	source.expiryTracker.forgetKey(key)
+	source.removeEntry(key)
+	source.notifier.notify(eventClassGeneric, "move_from", key)
+	destination.replaceEntry(key, e)
+	destination.notifier.notify(eventClassGeneric, "move_to", key)
+	return true
+}
+
+// Copy copies the value and expiry of the source in one database to the destination in another, replacing any value
+// at the destination only if replace is set. It returns false if the source does not exist or the destination
+// exists and is not replaced.
+func (d *Databases) Copy(source string, from int, destination string, to int, replace bool) bool {
+	return copyEntry(d.stores[from], source, d.stores[to], destination, replace)
+}
@@ -0,0 +1,98 @@
+package store_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestDatabases(t *testing.T) {
+
+	t.Run("command log selects the database of a request when it changes", func(t *testing.T) {
+		buffer := bytes.NewBuffer(nil)
+		log := store.NewCommandLog(buffer)
+
+		require.NoError(t, log.Write(0, []byte("first\r\n")))
+		require.NoError(t, log.Write(0, []byte("second\r\n")))
+		require.NoError(t, log.Write(2, []byte("third\r\n")))
+
+		assert.Equal(t, "*2\r\n$6\r\nSELECT\r\n$1\r\n0\r\nfirst\r\nsecond\r\n*2\r\n$6\r\nSELECT\r\n$1\r\n2\r\nthird\r\n", buffer.String())
+	})
+
+	t.Run("command log writes a transaction as a block selecting databases within it", func(t *testing.T) {
+		buffer := bytes.NewBuffer(nil)
+		log := store.NewCommandLog(buffer)
+
+		require.NoError(t, log.Write(0, []byte("first\r\n")))
+		log.BeginTransaction()
+		require.NoError(t, log.Write(0, []byte("second\r\n")))
+		require.NoError(t, log.Write(1, []byte("third\r\n")))
+		assert.Equal(t, "*2\r\n$6\r\nSELECT\r\n$1\r\n0\r\nfirst\r\n", buffer.String())
+		require.NoError(t, log.EndTransaction())
+		log.BeginTransaction()
+		require.NoError(t, log.EndTransaction())
+		require.NoError(t, log.Write(1, []byte("fourth\r\n")))
+
+		assert.Equal(t, "*2\r\n$6\r\nSELECT\r\n$1\r\n0\r\nfirst\r\n"+
+			"*1\r\n$5\r\nMULTI\r\nsecond\r\n*2\r\n$6\r\nSELECT\r\n$1\r\n1\r\nthird\r\n*1\r\n$4\r\nEXEC\r\n"+
+			"fourth\r\n", buffer.String())
+	})
+
+	t.Run("keys found expired during a transaction are logged as deleted within its block", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		buffer := bytes.NewBuffer(nil)
+		databases := store.NewBuilder().WithClock(clock).WithCommandLogWriter(buffer).Build()
+		databases.Store(0).Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+		clock.AddMilliseconds(10)
+
+		databases.Log().BeginTransaction()
+		assert.False(t, databases.Store(0).Exists("key"))
+		require.NoError(t, databases.Log().Write(0, []byte("request\r\n")))
+		require.NoError(t, databases.Log().EndTransaction())
+
+		assert.Equal(t, "*1\r\n$5\r\nMULTI\r\n*2\r\n$6\r\nSELECT\r\n$1\r\n0\r\n*2\r\n$3\r\nDEL\r\n$3\r\nkey\r\n"+
+			"request\r\n*1\r\n$4\r\nEXEC\r\n", buffer.String())
+	})
+
+	t.Run("keys expiring after a swap are logged as deleted from the database they were swapped to", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		buffer := bytes.NewBuffer(nil)
+		databases := store.NewBuilder().WithClock(clock).WithCommandLogWriter(buffer).WithDatabaseCount(2).Build()
+		databases.Store(0).Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+
+		databases.Swap(0, 1)
+		clock.AddMilliseconds(10)
+
+		assert.False(t, databases.Store(1).Exists("key"))
+		assert.Equal(t, "*2\r\n$6\r\nSELECT\r\n$1\r\n1\r\n*2\r\n$3\r\nDEL\r\n$3\r\nkey\r\n", buffer.String())
+	})
+
+	t.Run("move keeps the expiry of the key", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		databases := store.NewBuilder().WithClock(clock).Build()
+		databases.Store(0).Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+
+		assert.True(t, databases.Move("key", 0, 1))
+
+		assert.False(t, databases.Store(0).Exists("key"))
+		expiry, ok, err := databases.Store(1).Expiry("key")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1_010), expiry)
+	})
+
+	t.Run("flush all removes keys without logging them as deleted", func(t *testing.T) {
+		buffer := bytes.NewBuffer(nil)
+		databases := store.NewBuilder().WithCommandLogWriter(buffer).Build()
+		databases.Store(0).Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10_000)
+		databases.Store(3).Write("key", "value", store.ExpiryOptionNone, 0)
+
+		databases.FlushAll()
+
+		assert.Equal(t, 0, databases.Store(0).Size())
+		assert.Equal(t, 0, databases.Store(3).Size())
+		assert.Empty(t, buffer.String())
+	})
+}
@@ -7,7 +7,12 @@ func (e Error) Error() string {
 }
 
 const (
-	ErrorKeyNotFound        Error = "key not found"
-	ErrorNotAnInteger       Error = "not an integer"
-	ErrorWrongOperationType Error = "wrong operation type"
+	ErrorKeyNotFound          Error = "key not found"
+	ErrorIndexOutOfRange      Error = "index out of range"
+	ErrorNotAnInteger         Error = "not an integer"
+	ErrorNotAFloat            Error = "not a float"
+	ErrorNotANumber           Error = "not a number"
+	ErrorNotANumberOrInfinity Error = "not a number or infinity"
+	ErrorOverflow             Error = "increment or decrement would overflow"
+	ErrorWrongOperationType   Error = "wrong operation type"
 )
@@ -1,17 +1,18 @@
 package store
 
 import (
-	"io"
+	"bytes"
 	"math/rand"
 	"redis-challenge/internal/protocol"
 )
 
 type deleteListener struct {
-	writer io.Writer
+	log      *CommandLog
+	database int
 }
 
 func (l *deleteListener) OnDelete(key string) {
-	if l == nil || l.writer == nil {
+	if l == nil || l.log == nil {
 		return
 	}
 
@@ -22,7 +23,11 @@ func (l *deleteListener) OnDelete(key string) {
 		},
 	}
 
-	err := protocol.WriteData(l.writer, cmd)
+	buffer := bytes.NewBuffer(nil)
+	err := protocol.WriteData(buffer, cmd)
+	if err == nil {
+		err = l.log.Write(l.database, buffer.Bytes())
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -73,17 +78,42 @@ func (t *ExpiryTracker) AddKey(key string) {
 	}
 }
 
+// RemoveKey stops tracking a key that has expired and logs it as deleted. Keys deleted or replaced by a command are
+// forgotten instead, as the command is logged itself.
 func (t *ExpiryTracker) RemoveKey(key string) {
+	if t.forgetKey(key) {
+		t.deleteListener.OnDelete(key)
+	}
+}
+
+// forgetKey stops tracking a key that still exists but no longer has an expiry, so it is not logged as deleted.
+// It returns true if the key was tracked.
+func (t *ExpiryTracker) forgetKey(key string) bool {
 	if t != nil {
 		delete(t.keyIsSet, key)
 		for i, k := range t.keys {
 			if k == key {
-				t.deleteListener.OnDelete(key)
 				t.keys = append(t.keys[:i], t.keys[i+1:]...)
-				break
+				return true
 			}
 		}
 	}
+	return false
+}
+
+// clear stops tracking every key without logging them as deleted, as when the keys are flushed.
+func (t *ExpiryTracker) clear() {
+	if t != nil {
+		t.keys = nil
+		t.keyIsSet = make(map[string]struct{})
+	}
+}
+
+// swapKeys swaps the keys tracked with another tracker, keeping the listeners where they are so deletes are still
+// logged against the database of each tracker.
+func (t *ExpiryTracker) swapKeys(other *ExpiryTracker) {
+	t.keys, other.keys = other.keys, t.keys
+	t.keyIsSet, other.keyIsSet = other.keyIsSet, t.keyIsSet
 }
 
 func (t *ExpiryTracker) withDeleteListener(listener *deleteListener) *ExpiryTracker {
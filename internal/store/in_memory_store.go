@@ -1,8 +1,12 @@
 package store
 
 import (
+	"math/big"
+	"math/rand"
 	"redis-challenge/internal/list"
+	"redis-challenge/internal/sortedset"
 	"strconv"
+	"time"
 )
 
 const maximumTimeInFuture = int64(9223372036854775807)
@@ -14,8 +18,11 @@ type entry struct {
 
 type InMemoryStore struct {
 	keyEntries    map[string]entry
+	scanOrder     *sortedset.SortedSet
 	clock         Clock
 	expiryTracker *ExpiryTracker
+	random        *rand.Rand
+	notifier      *keyspaceNotifier
 }
 
 func (s *InMemoryStore) Exists(key string) bool {
@@ -41,7 +48,8 @@ func (s *InMemoryStore) readEntry(key string) (entry, bool) {
 			return keyEntry, true
 		} else {
 			s.expiryTracker.RemoveKey(key)
-			delete(s.keyEntries, key)
+			s.removeEntry(key)
+			s.notifier.notify(eventClassExpired, "expired", key)
 		}
 	}
 	return entry{}, false
@@ -50,9 +58,12 @@ func (s *InMemoryStore) readEntry(key string) (entry, bool) {
 func (s *InMemoryStore) Delete(key string) bool {
 	existed := s.Exists(key)
 
-	delete(s.keyEntries, key)
-	s.expiryTracker.RemoveKey(key)
+	s.removeEntry(key)
+	s.expiryTracker.forgetKey(key)
 
+	if existed {
+		s.notifier.notify(eventClassGeneric, "del", key)
+	}
 	return existed
 }
 
@@ -61,15 +72,43 @@ func (s *InMemoryStore) Increment(key string, incrementBy int64) (int64, error)
 	if err != nil {
 		return 0, err
 	}
-	value += incrementBy
+	value, ok := addWithoutOverflow(value, incrementBy)
+	if !ok {
+		return 0, ErrorOverflow
+	}
 
 	stringValue := strconv.FormatInt(value, 10)
 
-	s.Write(key, stringValue, ExpiryOptionNone, 0)
+	s.UpdateString(key, stringValue, "incrby")
 
 	return value, nil
 }
 
+// IncrementFloat adds the increment to the floating-point number at the key, keeping any expiry, and returns the
+// result formatted as it is stored.
+func (s *InMemoryStore) IncrementFloat(key string, incrementBy *big.Float) (string, error) {
+	value := new(big.Float)
+	if e, hasEntry := s.readEntry(key); hasEntry {
+		text, ok := e.data.(string)
+		if !ok {
+			return "", ErrorWrongOperationType
+		}
+		if value, ok = ParseLongDouble(text); !ok {
+			return "", ErrorNotAFloat
+		}
+	}
+
+	value, err := addLongDoubles(value, incrementBy)
+	if err != nil {
+		return "", err
+	}
+
+	text := FormatLongDouble(value)
+	s.UpdateString(key, text, "incrbyfloat")
+
+	return text, nil
+}
+
 func (s *InMemoryStore) readInteger(key string) (int64, error) {
 	if e, hasEntry := s.readEntry(key); hasEntry {
 		if text, ok := e.data.(string); ok {
@@ -85,57 +124,110 @@ func (s *InMemoryStore) readInteger(key string) (int64, error) {
 }
 
 func (s *InMemoryStore) LeftPush(key string, values []string) (int64, error) {
-	oldList, _ := s.readEntry(key)
+	oldList, exists := s.readEntry(key)
 	updatedList, ok := list.LeftPush(values, oldList.data)
 	if !ok {
 		return 0, ErrorWrongOperationType
 	}
 
-	s.keyEntries[key] = entry{
+	s.putEntry(key, entry{
 		data:                     updatedList,
-		expiryTimeInMilliseconds: maximumTimeInFuture,
-	}
+		expiryTimeInMilliseconds: expiryOrNone(oldList, exists),
+	})
+	s.notifier.notify(eventClassList, "lpush", key)
 
 	return int64(updatedList.Len()), nil
 }
 
 func (s *InMemoryStore) RightPush(key string, values []string) (int64, error) {
-	oldList, _ := s.readEntry(key)
+	oldList, exists := s.readEntry(key)
 	updatedList, ok := list.RightPush(values, oldList.data)
 	if !ok {
 		return 0, ErrorWrongOperationType
 	}
 
-	s.keyEntries[key] = entry{
+	s.putEntry(key, entry{
 		data:                     updatedList,
-		expiryTimeInMilliseconds: maximumTimeInFuture,
-	}
+		expiryTimeInMilliseconds: expiryOrNone(oldList, exists),
+	})
+	s.notifier.notify(eventClassList, "rpush", key)
 
 	return int64(updatedList.Len()), nil
 }
 
+// expiryOrNone returns the expiry of an existing entry, so updating its value keeps the expiry.
+func expiryOrNone(e entry, exists bool) int64 {
+	if exists {
+		return e.expiryTimeInMilliseconds
+	}
+	return maximumTimeInFuture
+}
+
+// ReadListRange returns a copy of the values of the list from the start to the end index inclusive, as the reply may
+// be written after later pops and pushes have reused the storage of the list.
 func (s *InMemoryStore) ReadListRange(key string, fromIndex int, toIndex int) (list.DoubleEndedList, error) {
-	if values, ok := list.ReadRangeFromStoreList(s.keyEntries[key].data, fromIndex, toIndex); ok {
-		return values, nil
+	e, _ := s.readEntry(key)
+	if values, ok := list.ReadRangeFromStoreList(e.data, fromIndex, toIndex); ok {
+		return values.Copy(), nil
 	}
 	return list.DoubleEndedList{}, ErrorWrongOperationType
 }
 
 func (s *InMemoryStore) Write(key string, value string, expiryOption ExpiryOption, expiry int64) {
-	if expiryOption == ExpiryOptionNone {
-		s.expiryTracker.RemoveKey(key)
-	} else {
+	if !s.writeString(key, value, expiryOption, expiry) {
+		return
+	}
+
+	s.notifier.notify(eventClassString, "set", key)
+	if expiryOption != ExpiryOptionNone && expiryOption != ExpiryOptionExpiryKeepTTL {
+		s.notifier.notify(eventClassGeneric, "expire", key)
+	}
+}
+
+// UpdateString replaces the string at the key keeping any expiry, notifying the event of the command that changed
+// it.
+func (s *InMemoryStore) UpdateString(key string, value string, event string) {
+	if s.writeString(key, value, ExpiryOptionExpiryKeepTTL, 0) {
+		s.notifier.notify(eventClassString, event, key)
+	}
+}
+
+// writeString stores the string with the expiry, returning false if it is not stored as the expiry has passed.
+func (s *InMemoryStore) writeString(key string, value string, expiryOption ExpiryOption, expiry int64) bool {
+	switch expiryOption {
+	case ExpiryOptionNone:
+		s.expiryTracker.forgetKey(key)
+	case ExpiryOptionExpiryKeepTTL:
+		// the key is already tracked if it has an expiry to keep
+	default:
 		s.expiryTracker.AddKey(key)
 	}
 
 	expiryTimestamp, ok := s.expiryTimeInMilliseconds(key, expiryOption, expiry)
 
 	if ok {
-		s.keyEntries[key] = entry{
+		s.putEntry(key, entry{
 			data:                     value,
 			expiryTimeInMilliseconds: expiryTimestamp,
-		}
+		})
+	}
+	return ok
+}
+
+// replaceWithCollection replaces any value at the key with the collection without an expiry, notifying the event,
+// or removes the key if the collection is empty.
+func (s *InMemoryStore) replaceWithCollection(key string, collection any, length int, class eventClass, event string) {
+	if length == 0 {
+		s.Delete(key)
+		return
 	}
+
+	s.expiryTracker.forgetKey(key)
+	s.putEntry(key, entry{
+		data:                     collection,
+		expiryTimeInMilliseconds: maximumTimeInFuture,
+	})
+	s.notifier.notify(class, event, key)
 }
 
 func (s *InMemoryStore) expiryTimeInMilliseconds(key string, expiryOption ExpiryOption, expiry int64) (int64, bool) {
@@ -181,6 +273,18 @@ func (s *InMemoryStore) WithClock(clock Clock) *InMemoryStore {
 	return s
 }
 
+// withNotifier notifies the events changing keys as the events of the database.
+func (s *InMemoryStore) withNotifier(notifier *keyspaceNotifier) *InMemoryStore {
+	s.notifier = notifier
+	return s
+}
+
+// WithRandomSeed seeds the random selection of members, so commands like SPOP can be made deterministic.
+func (s *InMemoryStore) WithRandomSeed(seed int64) *InMemoryStore {
+	s.random = rand.New(rand.NewSource(seed))
+	return s
+}
+
 func New() *InMemoryStore {
 	return NewWithClock(SystemClock{})
 }
@@ -188,6 +292,8 @@ func New() *InMemoryStore {
 func NewWithClock(clock Clock) *InMemoryStore {
 	return &InMemoryStore{
 		keyEntries: make(map[string]entry),
+		scanOrder:  sortedset.New(),
 		clock:      clock,
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
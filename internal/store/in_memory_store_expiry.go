@@ -0,0 +1,47 @@
+package store
+
+// Expiry returns the time in milliseconds when the key expires, or false if the key has no expiry.
+func (s *InMemoryStore) Expiry(key string) (int64, bool, error) {
+	e, ok := s.readEntry(key)
+	if !ok {
+		return 0, false, ErrorKeyNotFound
+	}
+	if e.expiryTimeInMilliseconds == maximumTimeInFuture {
+		return 0, false, nil
+	}
+	return e.expiryTimeInMilliseconds, true, nil
+}
+
+// SetExpiry sets the time in milliseconds when the key expires, deleting the key if the time has already passed.
+// It returns false if the key does not exist.
+func (s *InMemoryStore) SetExpiry(key string, timestamp int64) bool {
+	e, ok := s.readEntry(key)
+	if !ok {
+		return false
+	}
+
+	if timestamp <= s.clock.Now() {
+		s.Delete(key)
+		return true
+	}
+
+	e.expiryTimeInMilliseconds = timestamp
+	s.putEntry(key, e)
+	s.expiryTracker.AddKey(key)
+	s.notifier.notify(eventClassGeneric, "expire", key)
+	return true
+}
+
+// Persist removes the expiry of the key, returning false if the key does not exist or has no expiry.
+func (s *InMemoryStore) Persist(key string) bool {
+	e, ok := s.readEntry(key)
+	if !ok || e.expiryTimeInMilliseconds == maximumTimeInFuture {
+		return false
+	}
+
+	e.expiryTimeInMilliseconds = maximumTimeInFuture
+	s.putEntry(key, e)
+	s.expiryTracker.forgetKey(key)
+	s.notifier.notify(eventClassGeneric, "persist", key)
+	return true
+}
@@ -0,0 +1,151 @@
+package store
+
+import (
+	"math"
+	"math/big"
+	"redis-challenge/internal/hash"
+	"strconv"
+)
+
+// ReadHash returns the hash stored at the key, which is nil if there is no key.
+func (s *InMemoryStore) ReadHash(key string) (*hash.Hash, error) {
+	if e, ok := s.readEntry(key); ok {
+		if h, ok := e.data.(*hash.Hash); ok {
+			return h, nil
+		}
+		return nil, ErrorWrongOperationType
+	}
+	return nil, nil
+}
+
+func (s *InMemoryStore) HashSet(key string, entries []hash.Entry) (int64, error) {
+	h, err := s.ReadHash(key)
+	if err != nil {
+		return 0, err
+	}
+	if h == nil {
+		h = s.createHash(key)
+	}
+
+	var addedCount int64
+	for _, e := range entries {
+		if h.Set(e.Field, e.Value) {
+			addedCount++
+		}
+	}
+	s.notifier.notify(eventClassHash, "hset", key)
+	return addedCount, nil
+}
+
+func (s *InMemoryStore) HashSetIfMissing(key string, field string, value string) (bool, error) {
+	h, err := s.ReadHash(key)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := h.Get(field); ok {
+		return false, nil
+	}
+	if h == nil {
+		h = s.createHash(key)
+	}
+
+	h.Set(field, value)
+	s.notifier.notify(eventClassHash, "hset", key)
+	return true, nil
+}
+
+func (s *InMemoryStore) HashDelete(key string, fields []string) (int64, error) {
+	h, err := s.ReadHash(key)
+	if err != nil || h == nil {
+		return 0, err
+	}
+
+	var deletedCount int64
+	for _, field := range fields {
+		if h.Delete(field) {
+			deletedCount++
+		}
+	}
+	if deletedCount > 0 {
+		s.notifier.notify(eventClassHash, "hdel", key)
+	}
+
+	if h.Len() == 0 {
+		s.Delete(key)
+	}
+	return deletedCount, nil
+}
+
+func (s *InMemoryStore) HashIncrement(key string, field string, incrementBy int64) (int64, error) {
+	h, err := s.ReadHash(key)
+	if err != nil {
+		return 0, err
+	}
+
+	var value int64
+	if text, ok := h.Get(field); ok {
+		value, err = strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return 0, ErrorNotAnInteger
+		}
+	}
+
+	value, ok := addWithoutOverflow(value, incrementBy)
+	if !ok {
+		return 0, ErrorOverflow
+	}
+
+	if h == nil {
+		h = s.createHash(key)
+	}
+	h.Set(field, strconv.FormatInt(value, 10))
+	s.notifier.notify(eventClassHash, "hincrby", key)
+
+	return value, nil
+}
+
+func (s *InMemoryStore) HashIncrementFloat(key string, field string, incrementBy *big.Float) (string, error) {
+	h, err := s.ReadHash(key)
+	if err != nil {
+		return "", err
+	}
+
+	value := new(big.Float)
+	if text, ok := h.Get(field); ok {
+		if value, ok = ParseLongDouble(text); !ok {
+			return "", ErrorNotAFloat
+		}
+	}
+
+	value, err = addLongDoubles(value, incrementBy)
+	if err != nil {
+		return "", err
+	}
+
+	if h == nil {
+		h = s.createHash(key)
+	}
+	text := FormatLongDouble(value)
+	h.Set(field, text)
+	s.notifier.notify(eventClassHash, "hincrbyfloat", key)
+
+	return text, nil
+}
+
+func (s *InMemoryStore) createHash(key string) *hash.Hash {
+	h := hash.New()
+	s.putEntry(key, entry{
+		data:                     h,
+		expiryTimeInMilliseconds: maximumTimeInFuture,
+	})
+	return h
+}
+
+// addWithoutOverflow adds the increment to the value, returning false if the result overflows an int64.
+func addWithoutOverflow(value int64, increment int64) (int64, bool) {
+	if (increment < 0 && value < 0 && increment < math.MinInt64-value) ||
+		(increment > 0 && value > 0 && increment > math.MaxInt64-value) {
+		return 0, false
+	}
+	return value + increment, true
+}
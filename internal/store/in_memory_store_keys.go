@@ -0,0 +1,145 @@
+package store
+
+import (
+	"redis-challenge/internal/hash"
+	"redis-challenge/internal/list"
+	"redis-challenge/internal/set"
+	"redis-challenge/internal/sortedset"
+)
+
+// Keys returns every key that has not expired, in no particular order.
+func (s *InMemoryStore) Keys() []string {
+	keys := make([]string, 0, len(s.keyEntries))
+	for key := range s.keyEntries {
+		if _, ok := s.readEntry(key); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// RandomKey returns a key picked at random, or false if the store is empty.
+func (s *InMemoryStore) RandomKey() (string, bool) {
+	for len(s.keyEntries) > 0 {
+		index := s.random.Intn(len(s.keyEntries))
+		for key := range s.keyEntries {
+			if index > 0 {
+				index--
+				continue
+			}
+			if _, ok := s.readEntry(key); ok {
+				return key, true
+			}
+			break
+		}
+	}
+	return "", false
+}
+
+// Type returns the name of the type of value at the key, as reported by Redis, or none if the key does not exist.
+func (s *InMemoryStore) Type(key string) string {
+	e, ok := s.readEntry(key)
+	if !ok {
+		return "none"
+	}
+
+	switch e.data.(type) {
+	case string:
+		return "string"
+	case list.DoubleEndedList:
+		return "list"
+	case *hash.Hash:
+		return "hash"
+	case *set.Set:
+		return "set"
+	case *sortedset.SortedSet:
+		return "zset"
+	default:
+		return "none"
+	}
+}
+
+// Rename moves the value and expiry of the source to the destination, replacing any value at the destination unless
+// onlyIfMissing is set. It returns false if the value is not moved because the destination exists.
+func (s *InMemoryStore) Rename(source string, destination string, onlyIfMissing bool) (bool, error) {
+	e, ok := s.readEntry(source)
+	if !ok {
+		return false, ErrorKeyNotFound
+	}
+	if source == destination {
+		return !onlyIfMissing, nil
+	}
+	if onlyIfMissing && s.Exists(destination) {
+		return false, nil
+	}
+
+	s.expiryTracker.forgetKey(source)
+	s.removeEntry(source)
+	s.notifier.notify(eventClassGeneric, "rename_from", source)
+	s.replaceEntry(destination, e)
+	s.notifier.notify(eventClassGeneric, "rename_to", destination)
+	return true, nil
+}
+
+// Copy copies the value and expiry of the source to the destination, replacing any value at the destination only if
+// replace is set. It returns false if the source does not exist or the destination exists and is not replaced.
+func (s *InMemoryStore) Copy(source string, destination string, replace bool) bool {
+	return copyEntry(s, source, s, destination, replace)
+}
+
+// copyEntry copies the entry at the source in one store to the destination in another, which may be the same store.
+func copyEntry(from *InMemoryStore, source string, to *InMemoryStore, destination string, replace bool) bool {
+	e, ok := from.readEntry(source)
+	if !ok {
+		return false
+	}
+	if !replace && to.Exists(destination) {
+		return false
+	}
+
+	e.data = copyData(e.data)
+	to.replaceEntry(destination, e)
+	to.notifier.notify(eventClassGeneric, "copy_to", destination)
+	return true
+}
+
+// Flush removes every key without logging them as deleted, as the flush itself is logged.
+func (s *InMemoryStore) Flush() {
+	s.keyEntries = make(map[string]entry)
+	s.scanOrder = sortedset.New()
+	s.expiryTracker.clear()
+}
+
+// swapKeys swaps the keys and their expiry with another store, keeping the clock and expiry listeners of each.
+func (s *InMemoryStore) swapKeys(other *InMemoryStore) {
+	s.keyEntries, other.keyEntries = other.keyEntries, s.keyEntries
+	s.scanOrder, other.scanOrder = other.scanOrder, s.scanOrder
+	s.expiryTracker.swapKeys(other.expiryTracker)
+}
+
+// replaceEntry replaces any entry at the key, tracking the key only if the entry has an expiry.
+func (s *InMemoryStore) replaceEntry(key string, e entry) {
+	if e.expiryTimeInMilliseconds == maximumTimeInFuture {
+		s.expiryTracker.forgetKey(key)
+	} else {
+		s.expiryTracker.AddKey(key)
+	}
+	s.putEntry(key, e)
+}
+
+// copyData returns a copy of a stored value that does not share storage with it, where strings can be shared as
+// they are never changed in place.
+func copyData(data any) any {
+	switch d := data.(type) {
+	case list.DoubleEndedList:
+		return d.Copy()
+	case *hash.Hash:
+		return d.Copy()
+	case *set.Set:
+		return d.Copy()
+	case *sortedset.SortedSet:
+		return d.Copy()
+	default:
+		return data
+	}
+}
@@ -0,0 +1,176 @@
+package store
+
+import "redis-challenge/internal/list"
+
+// ListLength returns the number of values in the list at the key, which is zero if there is no key.
+func (s *InMemoryStore) ListLength(key string) (int, error) {
+	values, _, err := s.readList(key)
+	return values.Len(), err
+}
+
+// ListIndex returns the value at the index of the list, returning false if there is no key or the index is out
+// of range.
+func (s *InMemoryStore) ListIndex(key string, index int) (string, bool, error) {
+	values, _, err := s.readList(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	value, ok := values.Index(index)
+	return value, ok, nil
+}
+
+// ListSet replaces the value at the index of the list.
+func (s *InMemoryStore) ListSet(key string, index int, value string) error {
+	values, ok, err := s.readList(key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrorKeyNotFound
+	}
+
+	updated, ok := values.Set(index, value)
+	if !ok {
+		return ErrorIndexOutOfRange
+	}
+	s.updateList(key, updated, "lset")
+	return nil
+}
+
+// ListInsert inserts the value before or after the pivot, returning the length of the list, zero if there is no
+// key or -1 if the pivot is not in the list.
+func (s *InMemoryStore) ListInsert(key string, pivot string, value string, before bool) (int64, error) {
+	values, ok, err := s.readList(key)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	updated, ok := values.Insert(pivot, value, before)
+	if !ok {
+		return -1, nil
+	}
+	s.updateList(key, updated, "linsert")
+	return int64(updated.Len()), nil
+}
+
+// ListRemove removes count occurrences of the value, from the end of the list if the count is negative or every
+// occurrence if the count is zero, returning the number of values removed.
+func (s *InMemoryStore) ListRemove(key string, value string, count int) (int64, error) {
+	values, ok, err := s.readList(key)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	updated, removed := values.Remove(value, count)
+	if removed > 0 {
+		s.updateList(key, updated, "lrem")
+	}
+	return int64(removed), nil
+}
+
+// ListTrim keeps only the values from the start to the end index inclusive.
+func (s *InMemoryStore) ListTrim(key string, start int, end int) error {
+	values, ok, err := s.readList(key)
+	if err != nil || !ok {
+		return err
+	}
+
+	s.updateList(key, values.Trim(start, end), "ltrim")
+	return nil
+}
+
+// ListPositions returns the indexes of the matches of the value, following the RANK, COUNT and MAXLEN options of
+// LPOS.
+func (s *InMemoryStore) ListPositions(key string, value string, rank int, count int, maxLength int) ([]int, error) {
+	values, _, err := s.readList(key)
+	if err != nil {
+		return nil, err
+	}
+	return values.Positions(value, rank, count, maxLength), nil
+}
+
+// LeftPop removes up to count values from the start of the list, returning them in the order they were removed.
+func (s *InMemoryStore) LeftPop(key string, count int) ([]string, error) {
+	values, ok, err := s.readList(key)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	updated, popped := values.LeftPop(count)
+	if len(popped) > 0 {
+		s.updateList(key, updated, "lpop")
+	}
+	return popped, nil
+}
+
+// RightPop removes up to count values from the end of the list, returning them in the order they were removed.
+func (s *InMemoryStore) RightPop(key string, count int) ([]string, error) {
+	values, ok, err := s.readList(key)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	updated, popped := values.RightPop(count)
+	if len(popped) > 0 {
+		s.updateList(key, updated, "rpop")
+	}
+	return popped, nil
+}
+
+// ListMove pops a value from the start of the source list, or its end if fromRight, and pushes it to the start
+// of the destination list, or its end if toRight, returning false if there is no source key. The source and
+// destination may be the same list, rotating a value from one end to the other.
+func (s *InMemoryStore) ListMove(source string, destination string, fromRight bool, toRight bool) (string, bool, error) {
+	values, ok, err := s.readList(source)
+	if err != nil || !ok {
+		return "", false, err
+	}
+	if _, _, err := s.readList(destination); err != nil {
+		return "", false, err
+	}
+
+	var popped []string
+	if fromRight {
+		values, popped = values.RightPop(1)
+		s.updateList(source, values, "rpop")
+	} else {
+		values, popped = values.LeftPop(1)
+		s.updateList(source, values, "lpop")
+	}
+
+	push := s.LeftPush
+	if toRight {
+		push = s.RightPush
+	}
+	if _, err := push(destination, popped); err != nil {
+		return "", false, err
+	}
+	return popped[0], true, nil
+}
+
+// readList returns the list stored at the key, returning false if there is no key.
+func (s *InMemoryStore) readList(key string) (list.DoubleEndedList, bool, error) {
+	if e, ok := s.readEntry(key); ok {
+		if values, ok := e.data.(list.DoubleEndedList); ok {
+			return values, true, nil
+		}
+		return list.DoubleEndedList{}, false, ErrorWrongOperationType
+	}
+	return list.DoubleEndedList{}, false, nil
+}
+
+// updateList stores the list at an existing key keeping its expiry, notifying the event, and then removes the key if
+// the list is empty.
+func (s *InMemoryStore) updateList(key string, values list.DoubleEndedList, event string) {
+	s.notifier.notify(eventClassList, event, key)
+
+	if values.Len() == 0 {
+		s.Delete(key)
+		return
+	}
+
+	e := s.keyEntries[key]
+	e.data = values
+	s.putEntry(key, e)
+}
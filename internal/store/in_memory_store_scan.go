@@ -0,0 +1,64 @@
+package store
+
+import (
+	"math"
+	"redis-challenge/internal/scan"
+	"redis-challenge/internal/sortedset"
+)
+
+// putEntry stores the entry at the key, adding the key to the scan order and notifying it if it is new.
+func (s *InMemoryStore) putEntry(key string, e entry) {
+	_, exists := s.keyEntries[key]
+	if !exists {
+		s.scanOrder.Add(key, float64(scan.Position(key)))
+	}
+	s.keyEntries[key] = e
+
+	if !exists {
+		s.notifier.notify(eventClassNew, "new", key)
+	}
+}
+
+// removeEntry removes any entry at the key from the store and the scan order.
+func (s *InMemoryStore) removeEntry(key string) {
+	if _, ok := s.keyEntries[key]; ok {
+		delete(s.keyEntries, key)
+		s.scanOrder.Remove(key)
+	}
+}
+
+// Scan returns at least count keys from the cursor onwards, or every remaining key, with the cursor to continue
+// from, which is zero once every key has been returned. Keys sharing a position are returned together, so every key
+// that exists for the whole of a scan is returned at least once however the store changes between calls.
+func (s *InMemoryStore) Scan(cursor uint64, count int) (uint64, []string) {
+	from := sortedset.ScoreBound{Score: float64(cursor)}
+	page := s.scanOrder.EntriesInRange(positionsFrom(from), false, 0, count)
+
+	next := uint64(0)
+	if len(page) == count {
+		last := page[len(page)-1]
+		position := sortedset.ScoreBound{Score: last.Score}
+		for _, e := range s.scanOrder.EntriesInRange(sortedset.ScoreRange{Minimum: position, Maximum: position}, false, 0, -1) {
+			if e.Member > last.Member {
+				page = append(page, e)
+			}
+		}
+		position.Exclusive = true
+		if following := s.scanOrder.EntriesInRange(positionsFrom(position), false, 0, 1); len(following) > 0 {
+			next = uint64(following[0].Score)
+		}
+	}
+
+	keys := make([]string, 0, len(page))
+	for _, e := range page {
+		if _, ok := s.readEntry(e.Member); ok {
+			keys = append(keys, e.Member)
+		}
+	}
+	return next, keys
+}
+
+// positionsFrom selects every position from the bound onwards.
+func positionsFrom(bound sortedset.ScoreBound) sortedset.ScoreRange {
+	return sortedset.ScoreRange{Minimum: bound, Maximum: sortedset.ScoreBound{Score: math.Inf(1)}}
+}
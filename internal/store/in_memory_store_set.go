@@ -0,0 +1,196 @@
+package store
+
+import (
+	"redis-challenge/internal/set"
+)
+
+// ReadSet returns the set stored at the key, which is nil if there is no key.
+func (s *InMemoryStore) ReadSet(key string) (*set.Set, error) {
+	if e, ok := s.readEntry(key); ok {
+		if members, ok := e.data.(*set.Set); ok {
+			return members, nil
+		}
+		return nil, ErrorWrongOperationType
+	}
+	return nil, nil
+}
+
+func (s *InMemoryStore) SetAdd(key string, members []string) (int64, error) {
+	existing, err := s.ReadSet(key)
+	if err != nil {
+		return 0, err
+	}
+	if existing == nil {
+		existing = s.createSet(key)
+	}
+
+	var addedCount int64
+	for _, member := range members {
+		if existing.Add(member) {
+			addedCount++
+		}
+	}
+	if addedCount > 0 {
+		s.notifier.notify(eventClassSet, "sadd", key)
+	}
+	return addedCount, nil
+}
+
+func (s *InMemoryStore) SetRemove(key string, members []string) (int64, error) {
+	existing, err := s.ReadSet(key)
+	if err != nil || existing == nil {
+		return 0, err
+	}
+
+	var removedCount int64
+	for _, member := range members {
+		if existing.Remove(member) {
+			removedCount++
+		}
+	}
+	if removedCount > 0 {
+		s.notifier.notify(eventClassSet, "srem", key)
+	}
+
+	if existing.Len() == 0 {
+		s.Delete(key)
+	}
+	return removedCount, nil
+}
+
+// SetPop removes up to count members picked at random and returns them.
+func (s *InMemoryStore) SetPop(key string, count int) ([]string, error) {
+	existing, err := s.ReadSet(key)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+
+	popped := make([]string, 0, min(count, existing.Len()))
+	for len(popped) < count && existing.Len() > 0 {
+		member := existing.RandomMember(s.random)
+		existing.Remove(member)
+		popped = append(popped, member)
+	}
+	if len(popped) > 0 {
+		s.notifier.notify(eventClassSet, "spop", key)
+	}
+
+	if existing.Len() == 0 {
+		s.Delete(key)
+	}
+	return popped, nil
+}
+
+// SetRandomMembers returns up to count different members picked at random, or exactly -count members
+// which may repeat when the count is negative.
+func (s *InMemoryStore) SetRandomMembers(key string, count int) ([]string, error) {
+	existing, err := s.ReadSet(key)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+
+	if count >= 0 {
+		return existing.RandomDistinctMembers(s.random, count), nil
+	}
+
+	// the members are appended rather than allocated up front, as the count is chosen by the client
+	var members []string
+	for range -count {
+		members = append(members, existing.RandomMember(s.random))
+	}
+	return members, nil
+}
+
+// SetMove moves the member from the source set to the destination set, returning false if the source
+// does not contain the member.
+func (s *InMemoryStore) SetMove(source string, destination string, member string) (bool, error) {
+	sourceSet, err := s.ReadSet(source)
+	if err != nil {
+		return false, err
+	}
+	destinationSet, err := s.ReadSet(destination)
+	if err != nil {
+		return false, err
+	}
+
+	if source == destination {
+		return sourceSet.Contains(member), nil
+	}
+	if !sourceSet.Contains(member) {
+		return false, nil
+	}
+
+	sourceSet.Remove(member)
+	s.notifier.notify(eventClassSet, "srem", source)
+	if sourceSet.Len() == 0 {
+		s.Delete(source)
+	}
+
+	if destinationSet == nil {
+		destinationSet = s.createSet(destination)
+	}
+	if destinationSet.Add(member) {
+		s.notifier.notify(eventClassSet, "sadd", destination)
+	}
+	return true, nil
+}
+
+// WriteSet replaces any value at the key with the set, notifying the event of the command that stored it, or
+// removes the key if the set is empty.
+func (s *InMemoryStore) WriteSet(key string, members *set.Set, event string) {
+	s.replaceWithCollection(key, members, members.Len(), eventClassSet, event)
+}
+
+func (s *InMemoryStore) SetIntersection(keys []string) (*set.Set, error) {
+	sets, err := s.readSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return set.Intersection(sets), nil
+}
+
+func (s *InMemoryStore) SetIntersectionCardinality(keys []string, limit int) (int, error) {
+	sets, err := s.readSets(keys)
+	if err != nil {
+		return 0, err
+	}
+	return set.IntersectionCardinality(sets, limit), nil
+}
+
+func (s *InMemoryStore) SetUnion(keys []string) (*set.Set, error) {
+	sets, err := s.readSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return set.Union(sets), nil
+}
+
+func (s *InMemoryStore) SetDifference(keys []string) (*set.Set, error) {
+	sets, err := s.readSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return set.Difference(sets), nil
+}
+
+// readSets returns the set stored at each key, where missing keys are nil sets.
+func (s *InMemoryStore) readSets(keys []string) ([]*set.Set, error) {
+	sets := make([]*set.Set, len(keys))
+	for i, key := range keys {
+		members, err := s.ReadSet(key)
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = members
+	}
+	return sets, nil
+}
+
+func (s *InMemoryStore) createSet(key string) *set.Set {
+	members := set.New()
+	s.putEntry(key, entry{
+		data:                     members,
+		expiryTimeInMilliseconds: maximumTimeInFuture,
+	})
+	return members
+}
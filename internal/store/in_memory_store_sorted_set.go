@@ -0,0 +1,212 @@
+package store
+
+import (
+	"math"
+	"redis-challenge/internal/set"
+	"redis-challenge/internal/sortedset"
+)
+
+// ReadSortedSet returns the sorted set stored at the key, which is nil if there is no key.
+func (s *InMemoryStore) ReadSortedSet(key string) (*sortedset.SortedSet, error) {
+	if e, ok := s.readEntry(key); ok {
+		if members, ok := e.data.(*sortedset.SortedSet); ok {
+			return members, nil
+		}
+		return nil, ErrorWrongOperationType
+	}
+	return nil, nil
+}
+
+// SortedSetAdd sets the score of each member allowed by the condition, returning the count of members added and
+// the count of existing members whose score changed.
+func (s *InMemoryStore) SortedSetAdd(key string, entries []sortedset.Entry, condition sortedset.Condition) (int64, int64, error) {
+	existing, err := s.ReadSortedSet(key)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var addedCount, updatedCount int64
+	for _, e := range entries {
+		current, exists := existing.Score(e.Member)
+		if !condition.Allows(exists, current, e.Score) {
+			continue
+		}
+
+		if existing == nil {
+			existing = s.createSortedSet(key)
+		}
+		if existing.Add(e.Member, e.Score) {
+			addedCount++
+		} else if current != e.Score {
+			updatedCount++
+		}
+	}
+	if addedCount > 0 || updatedCount > 0 {
+		s.notifier.notify(eventClassSortedSet, "zadd", key)
+	}
+	return addedCount, updatedCount, nil
+}
+
+// SortedSetIncrement adds the increment to the score of the member, returning false if the condition does not
+// allow the new score.
+func (s *InMemoryStore) SortedSetIncrement(key string, member string, incrementBy float64, condition sortedset.Condition) (float64, bool, error) {
+	existing, err := s.ReadSortedSet(key)
+	if err != nil {
+		return 0, false, err
+	}
+
+	current, exists := existing.Score(member)
+	score := current + incrementBy
+	if math.IsNaN(score) {
+		return 0, false, ErrorNotANumber
+	}
+	if !condition.Allows(exists, current, score) {
+		return 0, false, nil
+	}
+
+	if existing == nil {
+		existing = s.createSortedSet(key)
+	}
+	existing.Add(member, score)
+	s.notifier.notify(eventClassSortedSet, "zincr", key)
+	return score, true, nil
+}
+
+// SortedSetRemove removes the members, notifying the event of the command that removed them, and returns the count
+// of members removed.
+func (s *InMemoryStore) SortedSetRemove(key string, members []string, event string) (int64, error) {
+	existing, err := s.ReadSortedSet(key)
+	if err != nil || existing == nil {
+		return 0, err
+	}
+
+	var removedCount int64
+	for _, member := range members {
+		if existing.Remove(member) {
+			removedCount++
+		}
+	}
+	if removedCount > 0 {
+		s.notifier.notify(eventClassSortedSet, event, key)
+	}
+
+	if existing.Len() == 0 {
+		s.Delete(key)
+	}
+	return removedCount, nil
+}
+
+// SortedSetPop removes up to count entries with the lowest scores, or with the highest scores if fromMaximum is
+// set, returning them in the order they were removed.
+func (s *InMemoryStore) SortedSetPop(key string, count int, fromMaximum bool) ([]sortedset.Entry, error) {
+	existing, err := s.ReadSortedSet(key)
+	if err != nil || existing == nil || count == 0 {
+		return nil, err
+	}
+
+	popped := existing.EntriesInRankRange(0, count-1, fromMaximum)
+	for _, e := range popped {
+		existing.Remove(e.Member)
+	}
+	if fromMaximum {
+		s.notifier.notify(eventClassSortedSet, "zpopmax", key)
+	} else {
+		s.notifier.notify(eventClassSortedSet, "zpopmin", key)
+	}
+
+	if existing.Len() == 0 {
+		s.Delete(key)
+	}
+	return popped, nil
+}
+
+// SortedSetRandomEntries returns up to count entries for different members picked at random, or exactly -count
+// entries which may repeat members if the count is negative.
+func (s *InMemoryStore) SortedSetRandomEntries(key string, count int) ([]sortedset.Entry, error) {
+	existing, err := s.ReadSortedSet(key)
+	if err != nil || existing == nil {
+		return nil, err
+	}
+
+	if count >= 0 {
+		return existing.RandomDistinctEntries(s.random, count), nil
+	}
+
+	// the entries are appended rather than allocated up front, as the count is chosen by the client
+	var entries []sortedset.Entry
+	for range -count {
+		entries = append(entries, existing.RandomEntry(s.random))
+	}
+	return entries, nil
+}
+
+// SortedSetUnion returns the union of the sorted sets at the keys, where a missing key is an empty sorted set and
+// the members of a set have a score of 1.
+func (s *InMemoryStore) SortedSetUnion(keys []string, weights []float64, aggregate sortedset.Aggregate) (*sortedset.SortedSet, error) {
+	sets, err := s.readSortedSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return sortedset.Union(sets, weights, aggregate), nil
+}
+
+// SortedSetIntersection returns the intersection of the sorted sets at the keys, where a missing key is an empty
+// sorted set and the members of a set have a score of 1.
+func (s *InMemoryStore) SortedSetIntersection(keys []string, weights []float64, aggregate sortedset.Aggregate) (*sortedset.SortedSet, error) {
+	sets, err := s.readSortedSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return sortedset.Intersection(sets, weights, aggregate), nil
+}
+
+// SortedSetDifference returns the entries of the first sorted set not found in the others, where a missing key is
+// an empty sorted set and the members of a set have a score of 1.
+func (s *InMemoryStore) SortedSetDifference(keys []string) (*sortedset.SortedSet, error) {
+	sets, err := s.readSortedSets(keys)
+	if err != nil {
+		return nil, err
+	}
+	return sortedset.Difference(sets), nil
+}
+
+// WriteSortedSet replaces any value at the key with the sorted set, notifying the event of the command that stored
+// it, or removes the key if the sorted set is empty.
+func (s *InMemoryStore) WriteSortedSet(key string, members *sortedset.SortedSet, event string) {
+	s.replaceWithCollection(key, members, members.Len(), eventClassSortedSet, event)
+}
+
+func (s *InMemoryStore) createSortedSet(key string) *sortedset.SortedSet {
+	members := sortedset.New()
+	s.putEntry(key, entry{
+		data:                     members,
+		expiryTimeInMilliseconds: maximumTimeInFuture,
+	})
+	return members
+}
+
+// readSortedSets returns the sorted set at each key, converting a set to a sorted set where every member has a
+// score of 1 as the aggregation commands accept both.
+func (s *InMemoryStore) readSortedSets(keys []string) ([]*sortedset.SortedSet, error) {
+	sets := make([]*sortedset.SortedSet, len(keys))
+	for i, key := range keys {
+		e, ok := s.readEntry(key)
+		if !ok {
+			continue
+		}
+
+		switch data := e.data.(type) {
+		case *sortedset.SortedSet:
+			sets[i] = data
+		case *set.Set:
+			converted := sortedset.New()
+			for member := range data.Members() {
+				converted.Add(member, 1)
+			}
+			sets[i] = converted
+		default:
+			return nil, ErrorWrongOperationType
+		}
+	}
+	return sets, nil
+}
@@ -41,6 +41,24 @@ func TestIncrementValuesInStore(t *testing.T) {
 		assert.Equal(t, "40", value)
 	})
 
+	t.Run("increment a number keeps the expiry", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1000}
+		s := store.NewWithClock(clock)
+		s.Write("key", "10", store.ExpiryOptionExpiryMilliseconds, 100)
+
+		_, err := s.Increment("key", int64(1))
+		require.Nil(t, err)
+
+		clock.AddMilliseconds(99)
+		value, err := s.ReadString("key")
+		require.Nil(t, err)
+		assert.Equal(t, "11", value)
+
+		clock.AddMilliseconds(1)
+		_, err = s.ReadString("key")
+		assert.Equal(t, store.ErrorKeyNotFound, err)
+	})
+
 	t.Run("increment a number of key with a non-integer", func(t *testing.T) {
 		s := store.New()
 		s.Write("key", "ten", store.ExpiryOptionNone, 0)
@@ -49,4 +67,38 @@ func TestIncrementValuesInStore(t *testing.T) {
 
 		assert.Equal(t, store.ErrorNotAnInteger, err)
 	})
+
+	t.Run("increment a number past the largest integer", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "9223372036854775807", store.ExpiryOptionNone, 0)
+
+		_, err := s.Increment("key", int64(1))
+
+		assert.Equal(t, store.ErrorOverflow, err)
+	})
+
+	t.Run("increment a float keeps the expiry", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1000}
+		s := store.NewWithClock(clock)
+		s.Write("key", "10.5", store.ExpiryOptionExpiryMilliseconds, 100)
+		increment, _ := store.ParseLongDouble("0.1")
+
+		updatedValue, err := s.IncrementFloat("key", increment)
+		require.Nil(t, err)
+		assert.Equal(t, "10.6", updatedValue)
+
+		clock.AddMilliseconds(100)
+		_, err = s.ReadString("key")
+		assert.Equal(t, store.ErrorKeyNotFound, err)
+	})
+
+	t.Run("increment a float of key with a non-float", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "ten", store.ExpiryOptionNone, 0)
+		increment, _ := store.ParseLongDouble("1")
+
+		_, err := s.IncrementFloat("key", increment)
+
+		assert.Equal(t, store.ErrorNotAFloat, err)
+	})
 }
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"math/big"
+	"strings"
+)
+
+// longDoublePrecision is the number of bits in the mantissa of the x87 long double used by Redis for
+// floating-point increments, so that results are rounded in the same way.
+const longDoublePrecision = 64
+
+// ParseLongDouble parses text as a floating-point number with the precision of a long double.
+func ParseLongDouble(text string) (*big.Float, bool) {
+	if text == "" || strings.TrimSpace(text) != text || strings.Contains(text, "_") {
+		return nil, false
+	}
+
+	value, ok := new(big.Float).SetPrec(longDoublePrecision).SetString(text)
+	if !ok {
+		return nil, false
+	}
+	return value, true
+}
+
+// FormatLongDouble formats the value as Redis does for a human-friendly long double, with 17 decimal places
+// and trailing zeros removed.
+func FormatLongDouble(value *big.Float) string {
+	text := value.Text('f', 17)
+
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(text, "0")
+		text = strings.TrimSuffix(text, ".")
+	}
+	if text == "-0" {
+		return "0"
+	}
+	return text
+}
+
+func addLongDoubles(value *big.Float, increment *big.Float) (*big.Float, error) {
+	if value.IsInf() || increment.IsInf() {
+		return nil, ErrorNotANumberOrInfinity
+	}
+	return new(big.Float).SetPrec(longDoublePrecision).Add(value, increment), nil
+}
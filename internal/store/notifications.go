@@ -0,0 +1,133 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+)
+
+// eventClass is a class of keyspace events, selected by its flag in the notify-keyspace-events setting.
+type eventClass int
+
+const (
+	eventClassGeneric eventClass = 1 << iota
+	eventClassString
+	eventClassList
+	eventClassSet
+	eventClassHash
+	eventClassSortedSet
+	eventClassExpired
+	eventClassEvicted
+	eventClassStream
+	eventClassKeyMiss
+	eventClassModule
+	eventClassNew
+	eventChannelsKeyspace
+	eventChannelsKeyevent
+
+	// eventClassAll is the classes selected by the A flag, which leaves out key misses and new keys.
+	eventClassAll = eventClassGeneric | eventClassString | eventClassList | eventClassSet | eventClassHash |
+		eventClassSortedSet | eventClassExpired | eventClassEvicted | eventClassStream | eventClassModule
+)
+
+// eventFlags are the flags of the notify-keyspace-events setting, in the order Redis reports them.
+var eventFlags = []struct {
+	flag  byte
+	class eventClass
+}{
+	{'g', eventClassGeneric},
+	{'$', eventClassString},
+	{'l', eventClassList},
+	{'s', eventClassSet},
+	{'h', eventClassHash},
+	{'z', eventClassSortedSet},
+	{'x', eventClassExpired},
+	{'e', eventClassEvicted},
+	{'t', eventClassStream},
+	{'d', eventClassModule},
+	{'K', eventChannelsKeyspace},
+	{'E', eventChannelsKeyevent},
+	{'m', eventClassKeyMiss},
+	{'n', eventClassNew},
+}
+
+// Publisher publishes a message to the subscribers of a channel.
+type Publisher interface {
+	Publish(channel string, message string) int
+}
+
+// KeyspaceEvents publishes the events changing keys in any database, as keyspace notifications on the channel of
+// the key with the event as the message, and as keyevent notifications on the channel of the event with the key as
+// the message. Only the classes of events selected with the notify-keyspace-events setting are published, which
+// selects none until it is set.
+type KeyspaceEvents struct {
+	publisher Publisher
+	selected  eventClass
+}
+
+func NewKeyspaceEvents(publisher Publisher) *KeyspaceEvents {
+	return &KeyspaceEvents{publisher: publisher}
+}
+
+// SetFlags selects the classes of events published with the flags of the notify-keyspace-events setting, returning
+// an error if a flag is not known.
+func (k *KeyspaceEvents) SetFlags(flags string) error {
+	var selected eventClass
+
+flags:
+	for i := 0; i < len(flags); i++ {
+		if flags[i] == 'A' {
+			selected |= eventClassAll
+			continue
+		}
+		for _, f := range eventFlags {
+			if f.flag == flags[i] {
+				selected |= f.class
+				continue flags
+			}
+		}
+		return fmt.Errorf("unknown keyspace event flag '%c'", flags[i])
+	}
+
+	k.selected = selected
+	return nil
+}
+
+// Flags returns the flags of the notify-keyspace-events setting, with A in place of the classes it selects.
+func (k *KeyspaceEvents) Flags() string {
+	var flags strings.Builder
+	if k.selected&eventClassAll == eventClassAll {
+		flags.WriteByte('A')
+	}
+	for _, f := range eventFlags {
+		if k.selected&f.class != 0 && (f.class&eventClassAll == 0 || k.selected&eventClassAll != eventClassAll) {
+			flags.WriteByte(f.flag)
+		}
+	}
+	return flags.String()
+}
+
+// notify publishes the event on the key in the database if its class is selected.
+func (k *KeyspaceEvents) notify(database int, class eventClass, event string, key string) {
+	if k == nil || k.publisher == nil || k.selected&class == 0 {
+		return
+	}
+
+	if k.selected&eventChannelsKeyspace != 0 {
+		k.publisher.Publish(fmt.Sprintf("__keyspace@%d__:%s", database, key), event)
+	}
+	if k.selected&eventChannelsKeyevent != 0 {
+		k.publisher.Publish(fmt.Sprintf("__keyevent@%d__:%s", database, event), key)
+	}
+}
+
+// keyspaceNotifier notifies the events of a single database.
+type keyspaceNotifier struct {
+	events   *KeyspaceEvents
+	database int
+}
+
+func (n *keyspaceNotifier) notify(class eventClass, event string, key string) {
+	if n != nil {
+		n.events.notify(n.database, class, event, key)
+	}
+}
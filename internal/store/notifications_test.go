@@ -0,0 +1,131 @@
+package store_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+type publishedMessage struct {
+	channel string
+	message string
+}
+
+type recordingPublisher struct {
+	messages []publishedMessage
+}
+
+func (p *recordingPublisher) Publish(channel string, message string) int {
+	p.messages = append(p.messages, publishedMessage{channel: channel, message: message})
+	return 0
+}
+
+func TestKeyspaceEvents(t *testing.T) {
+
+	t.Run("flags are reported with A in place of the classes it selects", func(t *testing.T) {
+		events := store.NewKeyspaceEvents(nil)
+		assert.Equal(t, "", events.Flags())
+
+		require.NoError(t, events.SetFlags("KEA"))
+		assert.Equal(t, "AKE", events.Flags())
+
+		require.NoError(t, events.SetFlags("Egx$lshzetdn"))
+		assert.Equal(t, "AEn", events.Flags())
+
+		require.NoError(t, events.SetFlags("lKg"))
+		assert.Equal(t, "glK", events.Flags())
+	})
+
+	t.Run("an unknown flag is an error that keeps the flags", func(t *testing.T) {
+		events := store.NewKeyspaceEvents(nil)
+		require.NoError(t, events.SetFlags("Kg"))
+
+		assert.Error(t, events.SetFlags("KgZ"))
+		assert.Equal(t, "gK", events.Flags())
+	})
+
+	t.Run("events are published on the keyspace and keyevent channels of the database", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithKeyspacePublisher(publisher).WithDatabaseCount(2).Build()
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("KEA"))
+
+		databases.Store(1).Write("key", "value", store.ExpiryOptionNone, 0)
+		databases.Store(1).Delete("key")
+
+		assert.Equal(t, []publishedMessage{
+			{channel: "__keyspace@1__:key", message: "set"},
+			{channel: "__keyevent@1__:set", message: "key"},
+			{channel: "__keyspace@1__:key", message: "del"},
+			{channel: "__keyevent@1__:del", message: "key"},
+		}, publisher.messages)
+	})
+
+	t.Run("only events of the selected classes are published", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithKeyspacePublisher(publisher).Build()
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("El"))
+
+		databases.Store(0).Write("key", "value", store.ExpiryOptionNone, 0)
+		_, err := databases.Store(0).LeftPush("list", []string{"a"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []publishedMessage{{channel: "__keyevent@0__:lpush", message: "list"}}, publisher.messages)
+	})
+
+	t.Run("pops of no values are not published", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithKeyspacePublisher(publisher).Build()
+		_, err := databases.Store(0).RightPush("list", []string{"a"})
+		require.NoError(t, err)
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("El"))
+
+		_, err = databases.Store(0).LeftPop("list", 0)
+		require.NoError(t, err)
+		_, err = databases.Store(0).RightPop("list", 0)
+		require.NoError(t, err)
+		_, err = databases.Store(0).RightPop("list", 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, []publishedMessage{{channel: "__keyevent@0__:rpop", message: "list"}}, publisher.messages)
+	})
+
+	t.Run("set pops of no members are not published", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithKeyspacePublisher(publisher).Build()
+		_, err := databases.Store(0).SetAdd("set", []string{"a"})
+		require.NoError(t, err)
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("Es"))
+
+		_, err = databases.Store(0).SetPop("set", 0)
+		require.NoError(t, err)
+		_, err = databases.Store(0).SetPop("set", 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, []publishedMessage{{channel: "__keyevent@0__:spop", message: "set"}}, publisher.messages)
+	})
+
+	t.Run("keys expiring when read are published as expired", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithClock(clock).WithKeyspacePublisher(publisher).Build()
+		databases.Store(0).Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("Ex"))
+
+		clock.AddMilliseconds(10)
+
+		assert.False(t, databases.Store(0).Exists("key"))
+		assert.Equal(t, []publishedMessage{{channel: "__keyevent@0__:expired", message: "key"}}, publisher.messages)
+	})
+
+	t.Run("new keys are published only when selected", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		databases := store.NewBuilder().WithKeyspacePublisher(publisher).Build()
+		require.NoError(t, databases.KeyspaceEvents().SetFlags("En"))
+
+		databases.Store(0).Write("key", "first", store.ExpiryOptionNone, 0)
+		databases.Store(0).Write("key", "second", store.ExpiryOptionNone, 0)
+
+		assert.Equal(t, []publishedMessage{{channel: "__keyevent@0__:new", message: "key"}}, publisher.messages)
+	})
+}
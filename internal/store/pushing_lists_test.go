@@ -144,6 +144,25 @@ func TestPushingListsIntoStore(t *testing.T) {
 		assert.Equal(t, []string{"a", "b"}, listRange.ToList(), "list range should be read")
 	})
 
+	t.Run("pushing to a list with an expiry should keep the expiry", func(t *testing.T) {
+		// Given a list with an expiry
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		_, err := s.RightPush("key", []string{"a"})
+		require.NoError(t, err)
+		s.SetExpiry("key", 1_100)
+
+		// When pushing to either end
+		_, err = s.LeftPush("key", []string{"b"})
+		require.NoError(t, err)
+		_, err = s.RightPush("key", []string{"c"})
+		require.NoError(t, err)
+
+		// Then the list still expires
+		clock.AddMilliseconds(100)
+		assert.False(t, s.Exists("key"))
+	})
+
 	t.Run("reading a string value against a list should error", func(t *testing.T) {
 		// Given empty store
 		s := store.New()
@@ -1,18 +1,79 @@
 package store
 
-import "redis-challenge/internal/list"
+import (
+	"math/big"
+	"redis-challenge/internal/hash"
+	"redis-challenge/internal/list"
+	"redis-challenge/internal/set"
+	"redis-challenge/internal/sortedset"
+)
 
 type Store interface {
 	ReadString(key string) (string, error)
 	ReadListRange(key string, fromIndex int, toIndex int) (list.DoubleEndedList, error)
 	Exists(key string) bool
 
+	Keys() []string
+	RandomKey() (string, bool)
+	Type(key string) string
+	Size() int
+	Scan(cursor uint64, count int) (uint64, []string)
+
 	Write(key string, value string, expiryOption ExpiryOption, expiry int64)
+	UpdateString(key string, value string, event string)
 	Delete(key string) bool
+	Rename(source string, destination string, onlyIfMissing bool) (bool, error)
+	Copy(source string, destination string, replace bool) bool
+	Flush()
+
+	Expiry(key string) (int64, bool, error)
+	SetExpiry(key string, timestamp int64) bool
+	Persist(key string) bool
 
 	Increment(key string, incrementBy int64) (int64, error)
+	IncrementFloat(key string, incrementBy *big.Float) (string, error)
 	LeftPush(key string, values []string) (int64, error)
 	RightPush(key string, values []string) (int64, error)
+	LeftPop(key string, count int) ([]string, error)
+	RightPop(key string, count int) ([]string, error)
+	ListLength(key string) (int, error)
+	ListIndex(key string, index int) (string, bool, error)
+	ListSet(key string, index int, value string) error
+	ListInsert(key string, pivot string, value string, before bool) (int64, error)
+	ListRemove(key string, value string, count int) (int64, error)
+	ListTrim(key string, start int, end int) error
+	ListPositions(key string, value string, rank int, count int, maxLength int) ([]int, error)
+	ListMove(source string, destination string, fromRight bool, toRight bool) (string, bool, error)
+
+	ReadHash(key string) (*hash.Hash, error)
+	HashSet(key string, entries []hash.Entry) (int64, error)
+	HashSetIfMissing(key string, field string, value string) (bool, error)
+	HashDelete(key string, fields []string) (int64, error)
+	HashIncrement(key string, field string, incrementBy int64) (int64, error)
+	HashIncrementFloat(key string, field string, incrementBy *big.Float) (string, error)
+
+	ReadSet(key string) (*set.Set, error)
+	SetAdd(key string, members []string) (int64, error)
+	SetRemove(key string, members []string) (int64, error)
+	SetPop(key string, count int) ([]string, error)
+	SetRandomMembers(key string, count int) ([]string, error)
+	SetMove(source string, destination string, member string) (bool, error)
+	SetIntersection(keys []string) (*set.Set, error)
+	SetIntersectionCardinality(keys []string, limit int) (int, error)
+	SetUnion(keys []string) (*set.Set, error)
+	SetDifference(keys []string) (*set.Set, error)
+	WriteSet(key string, members *set.Set, event string)
+
+	ReadSortedSet(key string) (*sortedset.SortedSet, error)
+	SortedSetAdd(key string, entries []sortedset.Entry, condition sortedset.Condition) (int64, int64, error)
+	SortedSetIncrement(key string, member string, incrementBy float64, condition sortedset.Condition) (float64, bool, error)
+	SortedSetRemove(key string, members []string, event string) (int64, error)
+	SortedSetPop(key string, count int, fromMaximum bool) ([]sortedset.Entry, error)
+	SortedSetRandomEntries(key string, count int) ([]sortedset.Entry, error)
+	SortedSetUnion(keys []string, weights []float64, aggregate sortedset.Aggregate) (*sortedset.SortedSet, error)
+	SortedSetIntersection(keys []string, weights []float64, aggregate sortedset.Aggregate) (*sortedset.SortedSet, error)
+	SortedSetDifference(keys []string) (*sortedset.SortedSet, error)
+	WriteSortedSet(key string, members *sortedset.SortedSet, event string)
 }
 
 type ExpiryOption string
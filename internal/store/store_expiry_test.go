@@ -0,0 +1,92 @@
+package store_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestStoreExpiry(t *testing.T) {
+
+	t.Run("expiry of a key without expiry is not set", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, hasExpiry, err := s.Expiry("key")
+
+		require.NoError(t, err)
+		assert.False(t, hasExpiry)
+	})
+
+	t.Run("expiry of a missing key is not found", func(t *testing.T) {
+		s := store.New()
+
+		_, _, err := s.Expiry("key")
+
+		assert.Equal(t, store.ErrorKeyNotFound, err)
+	})
+
+	t.Run("setting expiry of a list expires it", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		_, err := s.RightPush("key", []string{"a"})
+		require.NoError(t, err)
+
+		assert.True(t, s.SetExpiry("key", 1_500))
+
+		expiry, hasExpiry, err := s.Expiry("key")
+		require.NoError(t, err)
+		assert.True(t, hasExpiry)
+		assert.Equal(t, int64(1_500), expiry)
+
+		clock.AddMilliseconds(500)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("setting expiry in the past deletes the key", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		assert.True(t, s.SetExpiry("key", 1_000))
+
+		assert.Equal(t, 0, s.Size())
+	})
+
+	t.Run("setting expiry of a missing key does nothing", func(t *testing.T) {
+		s := store.New()
+
+		assert.False(t, s.SetExpiry("key", 1_000))
+	})
+
+	t.Run("setting expiry tracks the key", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.New().WithExpiryTracker(tracker)
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		s.SetExpiry("key", store.SystemClock{}.Now()+1_000)
+
+		assert.Equal(t, []string{"key"}, tracker.SelectKeys(10))
+	})
+
+	t.Run("persisting a key removes its expiry and tracking", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.New().WithExpiryTracker(tracker)
+		s.Write("key", "value", store.ExpiryOptionExpirySeconds, 1)
+
+		assert.True(t, s.Persist("key"))
+
+		_, hasExpiry, err := s.Expiry("key")
+		require.NoError(t, err)
+		assert.False(t, hasExpiry)
+		assert.Empty(t, tracker.SelectKeys(10))
+	})
+
+	t.Run("persisting a key without expiry does nothing", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		assert.False(t, s.Persist("key"))
+	})
+}
@@ -0,0 +1,115 @@
+package store_test
+
+import (
+	"redis-challenge/internal/hash"
+	"redis-challenge/internal/store"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashesInStore(t *testing.T) {
+
+	t.Run("setting fields of a missing key creates a hash", func(t *testing.T) {
+		s := store.New()
+
+		count, err := s.HashSet("key", []hash.Entry{{Field: "f1", Value: "v1"}, {Field: "f2", Value: "v2"}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		h, err := s.ReadHash("key")
+		require.NoError(t, err)
+		value, ok := h.Get("f2")
+		assert.True(t, ok)
+		assert.Equal(t, "v2", value)
+	})
+
+	t.Run("reading a missing key is an empty hash", func(t *testing.T) {
+		s := store.New()
+
+		h, err := s.ReadHash("key")
+		require.NoError(t, err)
+		assert.Equal(t, 0, h.Len())
+	})
+
+	t.Run("setting fields of a key with a string value is the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, err := s.HashSet("key", []hash.Entry{{Field: "f1", Value: "v1"}})
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		_, err = s.ReadHash("key")
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
+
+	t.Run("deleting the last field of a hash removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.HashSet("key", []hash.Entry{{Field: "f1", Value: "v1"}, {Field: "f2", Value: "v2"}})
+		require.NoError(t, err)
+
+		count, err := s.HashDelete("key", []string{"f1", "f2", "f3"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("setting a missing field only if missing adds it", func(t *testing.T) {
+		s := store.New()
+
+		added, err := s.HashSetIfMissing("key", "f1", "v1")
+		require.NoError(t, err)
+		assert.True(t, added)
+
+		added, err = s.HashSetIfMissing("key", "f1", "v2")
+		require.NoError(t, err)
+		assert.False(t, added)
+	})
+
+	t.Run("incrementing a field beyond the maximum integer overflows", func(t *testing.T) {
+		s := store.New()
+
+		_, err := s.HashIncrement("key", "f1", 9223372036854775807)
+		require.NoError(t, err)
+
+		_, err = s.HashIncrement("key", "f1", 1)
+		assert.Equal(t, store.ErrorOverflow, err)
+	})
+
+	t.Run("incrementing a field below the minimum integer overflows", func(t *testing.T) {
+		s := store.New()
+
+		_, err := s.HashIncrement("key", "f1", -9223372036854775808)
+		require.NoError(t, err)
+
+		_, err = s.HashIncrement("key", "f1", -1)
+		assert.Equal(t, store.ErrorOverflow, err)
+	})
+
+	t.Run("incrementing a field by a float uses long double precision", func(t *testing.T) {
+		s := store.New()
+
+		increment, ok := store.ParseLongDouble("0.1")
+		require.True(t, ok)
+		_, err := s.HashIncrementFloat("key", "f1", increment)
+		require.NoError(t, err)
+
+		increment, ok = store.ParseLongDouble("0.2")
+		require.True(t, ok)
+		value, err := s.HashIncrementFloat("key", "f1", increment)
+		require.NoError(t, err)
+
+		assert.Equal(t, "0.3", value)
+	})
+
+	t.Run("incrementing a field that is not a float is an error", func(t *testing.T) {
+		s := store.New()
+		_, err := s.HashSet("key", []hash.Entry{{Field: "f1", Value: "text"}})
+		require.NoError(t, err)
+
+		increment, _ := store.ParseLongDouble("1")
+		_, err = s.HashIncrementFloat("key", "f1", increment)
+		assert.Equal(t, store.ErrorNotAFloat, err)
+	})
+}
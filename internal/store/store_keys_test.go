@@ -0,0 +1,127 @@
+package store_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+func TestStoreKeys(t *testing.T) {
+
+	t.Run("keys leaves out expired keys", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		s.Write("key1", "value", store.ExpiryOptionNone, 0)
+		s.Write("key2", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+		_, err := s.RightPush("key3", []string{"a"})
+		require.NoError(t, err)
+
+		clock.AddMilliseconds(10)
+
+		assert.ElementsMatch(t, []string{"key1", "key3"}, s.Keys())
+		assert.Equal(t, 2, s.Size())
+	})
+
+	t.Run("random key of an empty store is not found", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		s.Write("key", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+
+		clock.AddMilliseconds(10)
+
+		_, ok := s.RandomKey()
+		assert.False(t, ok)
+	})
+
+	t.Run("random key picks one of the keys", func(t *testing.T) {
+		s := store.New().WithRandomSeed(1)
+		s.Write("key1", "value", store.ExpiryOptionNone, 0)
+		s.Write("key2", "value", store.ExpiryOptionNone, 0)
+
+		key, ok := s.RandomKey()
+		assert.True(t, ok)
+		assert.Contains(t, []string{"key1", "key2"}, key)
+	})
+
+	t.Run("type names the type of value", func(t *testing.T) {
+		s := store.New()
+		s.Write("string", "value", store.ExpiryOptionNone, 0)
+		_, err := s.RightPush("list", []string{"a"})
+		require.NoError(t, err)
+		_, err = s.SetAdd("set", []string{"a"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "string", s.Type("string"))
+		assert.Equal(t, "list", s.Type("list"))
+		assert.Equal(t, "set", s.Type("set"))
+		assert.Equal(t, "none", s.Type("missing"))
+	})
+
+	t.Run("rename moves the value and expiry", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		tracker := store.NewExpiryTracker()
+		s := store.NewWithClock(clock).WithExpiryTracker(tracker)
+		s.Write("source", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+		s.Write("destination", "old", store.ExpiryOptionNone, 0)
+
+		renamed, err := s.Rename("source", "destination", false)
+		require.NoError(t, err)
+		assert.True(t, renamed)
+
+		assert.False(t, s.Exists("source"))
+		expiry, hasExpiry, err := s.Expiry("destination")
+		require.NoError(t, err)
+		assert.True(t, hasExpiry)
+		assert.Equal(t, int64(1_010), expiry)
+		assert.Equal(t, []string{"destination"}, tracker.SelectKeys(10))
+	})
+
+	t.Run("rename of a missing key is not found", func(t *testing.T) {
+		s := store.New()
+
+		_, err := s.Rename("source", "destination", false)
+
+		assert.Equal(t, store.ErrorKeyNotFound, err)
+	})
+
+	t.Run("rename only if missing does not replace the destination", func(t *testing.T) {
+		s := store.New()
+		s.Write("source", "value", store.ExpiryOptionNone, 0)
+		s.Write("destination", "old", store.ExpiryOptionNone, 0)
+
+		renamed, err := s.Rename("source", "destination", true)
+		require.NoError(t, err)
+		assert.False(t, renamed)
+
+		value, _ := s.ReadString("destination")
+		assert.Equal(t, "old", value)
+	})
+
+	t.Run("copy does not share the value with the source", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("source", []string{"a"})
+		require.NoError(t, err)
+
+		assert.True(t, s.Copy("source", "destination", false))
+		_, err = s.RightPush("destination", []string{"b"})
+		require.NoError(t, err)
+
+		length, _ := s.ListLength("source")
+		assert.Equal(t, 1, length)
+		length, _ = s.ListLength("destination")
+		assert.Equal(t, 2, length)
+	})
+
+	t.Run("copy replaces the destination only when asked", func(t *testing.T) {
+		s := store.New()
+		s.Write("source", "value", store.ExpiryOptionNone, 0)
+		s.Write("destination", "old", store.ExpiryOptionNone, 0)
+
+		assert.False(t, s.Copy("source", "destination", false))
+		assert.True(t, s.Copy("source", "destination", true))
+
+		value, _ := s.ReadString("destination")
+		assert.Equal(t, "value", value)
+	})
+}
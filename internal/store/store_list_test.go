@@ -113,4 +113,170 @@ func TestStoreList(t *testing.T) {
 
 		assert.Equal(t, []string{"c", "d"}, values.ToList())
 	})
+
+	t.Run("popping every value removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "b"})
+		require.NoError(t, err)
+
+		popped, err := s.RightPop("key", 5)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"b", "a"}, popped)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("popping from a missing key pops nothing", func(t *testing.T) {
+		s := store.New()
+
+		popped, err := s.LeftPop("key", 1)
+		require.NoError(t, err)
+
+		assert.Empty(t, popped)
+	})
+
+	t.Run("reading the range of an expired list is empty", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		_, err := s.RightPush("key", []string{"a", "b"})
+		require.NoError(t, err)
+		s.SetExpiry("key", 1_010)
+
+		clock.AddMilliseconds(20)
+
+		values, err := s.ReadListRange("key", 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, values.Len())
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("a range read before pops and pushes is not changed by them", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "b", "c"})
+		require.NoError(t, err)
+
+		values, err := s.ReadListRange("key", 0, -1)
+		require.NoError(t, err)
+
+		_, err = s.RightPop("key", 2)
+		require.NoError(t, err)
+		_, err = s.RightPush("key", []string{"y", "z"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c"}, values.ToList())
+	})
+
+	t.Run("a range read before a value is set is not changed by it", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "b"})
+		require.NoError(t, err)
+
+		values, err := s.ReadListRange("key", 0, -1)
+		require.NoError(t, err)
+
+		require.NoError(t, s.ListSet("key", 0, "x"))
+
+		assert.Equal(t, []string{"a", "b"}, values.ToList())
+	})
+
+	t.Run("setting a value of a missing key is not found", func(t *testing.T) {
+		s := store.New()
+
+		err := s.ListSet("key", 0, "a")
+
+		assert.Equal(t, store.ErrorKeyNotFound, err)
+	})
+
+	t.Run("setting a value beyond the end of the list is out of range", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a"})
+		require.NoError(t, err)
+
+		err = s.ListSet("key", 1, "b")
+
+		assert.Equal(t, store.ErrorIndexOutOfRange, err)
+	})
+
+	t.Run("removing every value removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "a"})
+		require.NoError(t, err)
+
+		removed, err := s.ListRemove("key", "a", 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), removed)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("trimming to an empty range removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "b"})
+		require.NoError(t, err)
+
+		err = s.ListTrim("key", 1, 0)
+		require.NoError(t, err)
+
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("moving a value pops from the source and pushes to the destination", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("source", []string{"a", "b"})
+		require.NoError(t, err)
+		_, err = s.RightPush("destination", []string{"c"})
+		require.NoError(t, err)
+
+		value, ok, err := s.ListMove("source", "destination", true, false)
+		require.NoError(t, err)
+
+		assert.True(t, ok)
+		assert.Equal(t, "b", value)
+		values, err := s.ReadListRange("destination", 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, values.ToList())
+	})
+
+	t.Run("moving the last value of a list to itself rotates it", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("key", []string{"a", "b", "c"})
+		require.NoError(t, err)
+
+		value, ok, err := s.ListMove("key", "key", true, false)
+		require.NoError(t, err)
+
+		assert.True(t, ok)
+		assert.Equal(t, "c", value)
+		values, err := s.ReadListRange("key", 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "a", "b"}, values.ToList())
+	})
+
+	t.Run("moving to a destination which is not a list leaves the source", func(t *testing.T) {
+		s := store.New()
+		_, err := s.RightPush("source", []string{"a"})
+		require.NoError(t, err)
+		s.Write("destination", "value", store.ExpiryOptionNone, 0)
+
+		_, _, err = s.ListMove("source", "destination", false, false)
+
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+		length, err := s.ListLength("source")
+		require.NoError(t, err)
+		assert.Equal(t, 1, length)
+	})
+
+	t.Run("list operations on a key with a string value are the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, err := s.LeftPop("key", 1)
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		_, err = s.ListLength("key")
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		_, err = s.ListInsert("key", "a", "b", true)
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
 }
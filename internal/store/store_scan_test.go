@@ -0,0 +1,80 @@
+package store_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"redis-challenge/internal/store"
+	"testing"
+)
+
+// scanAll pages through every key with the count, calling between before each page.
+func scanAll(s *store.InMemoryStore, count int, between func(page int)) []string {
+	var keys []string
+	cursor := uint64(0)
+	for page := 0; ; page++ {
+		between(page)
+		next, found := s.Scan(cursor, count)
+		keys = append(keys, found...)
+		if next == 0 {
+			return keys
+		}
+		cursor = next
+	}
+}
+
+func TestStoreScan(t *testing.T) {
+
+	t.Run("scan returns every key once across pages", func(t *testing.T) {
+		s := store.New()
+		var expected []string
+		for i := range 100 {
+			key := fmt.Sprintf("key%d", i)
+			s.Write(key, "value", store.ExpiryOptionNone, 0)
+			expected = append(expected, key)
+		}
+
+		assert.ElementsMatch(t, expected, scanAll(s, 7, func(int) {}))
+	})
+
+	t.Run("scan returns every key present throughout while the keyspace grows", func(t *testing.T) {
+		s := store.New()
+		var expected []string
+		for i := range 50 {
+			key := fmt.Sprintf("key%d", i)
+			s.Write(key, "value", store.ExpiryOptionNone, 0)
+			expected = append(expected, key)
+		}
+
+		keys := scanAll(s, 3, func(page int) {
+			for i := range 20 {
+				s.Write(fmt.Sprintf("added%d-%d", page, i), "value", store.ExpiryOptionNone, 0)
+			}
+		})
+
+		for _, key := range expected {
+			assert.Contains(t, keys, key)
+		}
+	})
+
+	t.Run("scan leaves out deleted and expired keys", func(t *testing.T) {
+		clock := &store.FixedClock{TimeInMilliseconds: 1_000}
+		s := store.NewWithClock(clock)
+		s.Write("key1", "value", store.ExpiryOptionNone, 0)
+		s.Write("key2", "value", store.ExpiryOptionExpiryMilliseconds, 10)
+		s.Write("key3", "value", store.ExpiryOptionNone, 0)
+		s.Delete("key3")
+
+		clock.AddMilliseconds(10)
+
+		assert.Equal(t, []string{"key1"}, scanAll(s, 10, func(int) {}))
+	})
+
+	t.Run("scan of renamed keys follows the new name", func(t *testing.T) {
+		s := store.New()
+		s.Write("source", "value", store.ExpiryOptionNone, 0)
+		_, err := s.Rename("source", "destination", false)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"destination"}, scanAll(s, 10, func(int) {}))
+	})
+}
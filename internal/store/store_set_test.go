@@ -0,0 +1,150 @@
+package store_test
+
+import (
+	"redis-challenge/internal/store"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetsInStore(t *testing.T) {
+
+	t.Run("adding members to a missing key creates a set", func(t *testing.T) {
+		s := store.New()
+
+		count, err := s.SetAdd("key", []string{"m1", "m2", "m1"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		members, err := s.ReadSet("key")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"m1", "m2"}, slices.Collect(members.Members()))
+	})
+
+	t.Run("adding members to a key with a string value is the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, err := s.SetAdd("key", []string{"m1"})
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		_, err = s.ReadSet("key")
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
+
+	t.Run("removing the last member of a set removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.SetAdd("key", []string{"m1", "m2"})
+		require.NoError(t, err)
+
+		count, err := s.SetRemove("key", []string{"m1", "m2", "m3"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("popping every member of a set removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.SetAdd("key", []string{"m1", "m2"})
+		require.NoError(t, err)
+
+		popped, err := s.SetPop("key", 5)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"m1", "m2"}, popped)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("popping with the same seed pops the same members", func(t *testing.T) {
+		popWithSeed := func(seed int64) []string {
+			s := store.New().WithRandomSeed(seed)
+			_, err := s.SetAdd("key", []string{"m1", "m2", "m3", "m4", "m5", "m6"})
+			require.NoError(t, err)
+
+			popped, err := s.SetPop("key", 3)
+			require.NoError(t, err)
+			return popped
+		}
+
+		assert.Equal(t, popWithSeed(7), popWithSeed(7))
+	})
+
+	t.Run("random members with a positive count are distinct", func(t *testing.T) {
+		s := store.New().WithRandomSeed(1)
+		_, err := s.SetAdd("key", []string{"m1", "m2", "m3"})
+		require.NoError(t, err)
+
+		members, err := s.SetRandomMembers("key", 3)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"m1", "m2", "m3"}, members)
+	})
+
+	t.Run("random members with a negative count returns that many members", func(t *testing.T) {
+		s := store.New().WithRandomSeed(1)
+		_, err := s.SetAdd("key", []string{"m1", "m2"})
+		require.NoError(t, err)
+
+		members, err := s.SetRandomMembers("key", -5)
+		require.NoError(t, err)
+		assert.Len(t, members, 5)
+	})
+
+	t.Run("moving a member to a destination with a string value is the wrong type and moves nothing", func(t *testing.T) {
+		s := store.New()
+		_, err := s.SetAdd("source", []string{"m1"})
+		require.NoError(t, err)
+		s.Write("destination", "value", store.ExpiryOptionNone, 0)
+
+		_, err = s.SetMove("source", "destination", "m1")
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		members, err := s.ReadSet("source")
+		require.NoError(t, err)
+		assert.True(t, members.Contains("m1"))
+	})
+
+	t.Run("writing a set replaces the value and expiry of the key", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.NewWithClock(&store.FixedClock{TimeInMilliseconds: 1_000}).WithExpiryTracker(tracker)
+		s.Write("destination", "value", store.ExpiryOptionExpiryMilliseconds, 100)
+		_, err := s.SetAdd("a", []string{"m1", "m2"})
+		require.NoError(t, err)
+		_, err = s.SetAdd("b", []string{"m2", "m3"})
+		require.NoError(t, err)
+
+		union, err := s.SetUnion([]string{"a", "b"})
+		require.NoError(t, err)
+		s.WriteSet("destination", union, "sunionstore")
+
+		members, err := s.ReadSet("destination")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"m1", "m2", "m3"}, slices.Collect(members.Members()))
+		assert.Empty(t, tracker.SelectKeys(1), "should no longer track the expiry of the key")
+	})
+
+	t.Run("writing an empty set removes the key", func(t *testing.T) {
+		s := store.New()
+		_, err := s.SetAdd("a", []string{"m1"})
+		require.NoError(t, err)
+		_, err = s.SetAdd("destination", []string{"m2"})
+		require.NoError(t, err)
+
+		intersection, err := s.SetIntersection([]string{"a", "missing"})
+		require.NoError(t, err)
+		s.WriteSet("destination", intersection, "sinterstore")
+
+		assert.False(t, s.Exists("destination"))
+	})
+
+	t.Run("set algebra with a key with a string value is the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, err := s.SetDifference([]string{"missing", "key"})
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+
+		_, err = s.SetIntersectionCardinality([]string{"key"}, 0)
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
+}
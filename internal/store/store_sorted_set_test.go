@@ -0,0 +1,173 @@
+package store_test
+
+import (
+	"math"
+	"redis-challenge/internal/sortedset"
+	"redis-challenge/internal/store"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedSetsInStore(t *testing.T) {
+
+	t.Run("adding members to a missing key creates a sorted set", func(t *testing.T) {
+		s := store.New()
+
+		added, updated, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}, {Member: "m2", Score: 2}}, sortedset.Condition{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), added)
+		assert.Equal(t, int64(0), updated)
+
+		members, err := s.ReadSortedSet("key")
+		require.NoError(t, err)
+		rank, ok := members.Rank("m2")
+		assert.True(t, ok)
+		assert.Equal(t, 1, rank)
+	})
+
+	t.Run("adding the same score to an existing member is not an update", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}, {Member: "m2", Score: 2}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		added, updated, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}, {Member: "m2", Score: 3}}, sortedset.Condition{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), added)
+		assert.Equal(t, int64(1), updated)
+	})
+
+	t.Run("adding only existing members to a missing key does not create it", func(t *testing.T) {
+		s := store.New()
+
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{OnlyExisting: true})
+		require.NoError(t, err)
+
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("incrementing to NaN is an error and does not change the score", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: math.Inf(1)}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		_, _, err = s.SortedSetIncrement("key", "m1", math.Inf(-1), sortedset.Condition{})
+		assert.Equal(t, store.ErrorNotANumber, err)
+
+		members, err := s.ReadSortedSet("key")
+		require.NoError(t, err)
+		score, _ := members.Score("m1")
+		assert.Equal(t, math.Inf(1), score)
+	})
+
+	t.Run("incrementing with a condition that is not met leaves the score", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 5}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		_, ok, err := s.SortedSetIncrement("key", "m1", -1, sortedset.Condition{OnlyGreater: true})
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("removing the last member of a sorted set removes the key", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		count, err := s.SortedSetRemove("key", []string{"m1", "m2"}, "zrem")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("writing a sorted set replaces the value of another type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+		members := sortedset.New()
+		members.Add("m1", 1)
+
+		s.WriteSortedSet("key", members, "zunionstore")
+
+		written, err := s.ReadSortedSet("key")
+		require.NoError(t, err)
+		assert.Equal(t, 1, written.Len())
+	})
+
+	t.Run("writing an empty sorted set removes the key", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		s.WriteSortedSet("key", sortedset.New(), "zunionstore")
+
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("popping from the maximum returns the highest scores first", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}, {Member: "m2", Score: 2}, {Member: "m3", Score: 3}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		popped, err := s.SortedSetPop("key", 2, true)
+		require.NoError(t, err)
+		assert.Equal(t, []sortedset.Entry{{Member: "m3", Score: 3}, {Member: "m2", Score: 2}}, popped)
+
+		members, err := s.ReadSortedSet("key")
+		require.NoError(t, err)
+		assert.Equal(t, 1, members.Len())
+	})
+
+	t.Run("popping every member removes the key", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		popped, err := s.SortedSetPop("key", 5, false)
+		require.NoError(t, err)
+		assert.Len(t, popped, 1)
+		assert.False(t, s.Exists("key"))
+	})
+
+	t.Run("random entries with a negative count may repeat members", func(t *testing.T) {
+		s := store.New().WithRandomSeed(1)
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{})
+		require.NoError(t, err)
+
+		entries, err := s.SortedSetRandomEntries("key", -3)
+		require.NoError(t, err)
+		assert.Equal(t, []sortedset.Entry{{Member: "m1", Score: 1}, {Member: "m1", Score: 1}, {Member: "m1", Score: 1}}, entries)
+	})
+
+	t.Run("union treats the members of a set as having a score of 1", func(t *testing.T) {
+		s := store.New()
+		_, _, err := s.SortedSetAdd("sorted", []sortedset.Entry{{Member: "m1", Score: 2}}, sortedset.Condition{})
+		require.NoError(t, err)
+		_, err = s.SetAdd("set", []string{"m1", "m2"})
+		require.NoError(t, err)
+
+		union, err := s.SortedSetUnion([]string{"sorted", "set", "missing"}, []float64{1, 1, 1}, sortedset.AggregateSum)
+		require.NoError(t, err)
+		score, _ := union.Score("m1")
+		assert.Equal(t, float64(3), score)
+		score, _ = union.Score("m2")
+		assert.Equal(t, float64(1), score)
+	})
+
+	t.Run("aggregating a key with a string value is the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, err := s.SortedSetDifference([]string{"missing", "key"})
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
+
+	t.Run("adding members to a key with a string value is the wrong type", func(t *testing.T) {
+		s := store.New()
+		s.Write("key", "value", store.ExpiryOptionNone, 0)
+
+		_, _, err := s.SortedSetAdd("key", []sortedset.Entry{{Member: "m1", Score: 1}}, sortedset.Condition{})
+		assert.Equal(t, store.ErrorWrongOperationType, err)
+	})
+}
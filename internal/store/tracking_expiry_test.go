@@ -60,6 +60,37 @@ func TestTrackingExpiry(t *testing.T) {
 		assert.Empty(t, tracker.SelectKeys(10), "should have key removed as it is no longer with expiry")
 	})
 
+	t.Run("updating a key keeping its expiry is still tracked", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.New().WithExpiryTracker(tracker)
+
+		s.Write("key", "value 1", store.ExpiryOptionExpirySeconds, 1)
+		s.Write("key", "value 2", store.ExpiryOptionExpiryKeepTTL, 0)
+
+		assert.Equal(t, []string{"key"}, tracker.SelectKeys(10), "should have key as it keeps its expiry")
+	})
+
+	t.Run("updating a key without expiry keeping its expiry is not tracked", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.New().WithExpiryTracker(tracker)
+
+		s.Write("key", "value 1", store.ExpiryOptionNone, 0)
+		s.Write("key", "value 2", store.ExpiryOptionExpiryKeepTTL, 0)
+
+		assert.Empty(t, tracker.SelectKeys(10), "should not have key as it has no expiry to keep")
+	})
+
+	t.Run("incrementing a key with expiry is still tracked", func(t *testing.T) {
+		tracker := store.NewExpiryTracker()
+		s := store.New().WithExpiryTracker(tracker)
+
+		s.Write("key", "10", store.ExpiryOptionExpirySeconds, 1)
+		_, err := s.Increment("key", 1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"key"}, tracker.SelectKeys(10), "should have key as it keeps its expiry")
+	})
+
 	t.Run("deleting a key with expiry is not tracked", func(t *testing.T) {
 		tracker := store.NewExpiryTracker()
 		s := store.New().WithExpiryTracker(tracker)
@@ -30,11 +30,30 @@ func NewFromDataWithoutError(request []protocol.Data) DataCall {
 	}
 }
 
+// NewFromDataWithoutResponse sends the request without reading its response, such as a blocking command whose
+// response is read later by a call from NewResponseFromData.
+func NewFromDataWithoutResponse(request []protocol.Data) DataCall {
+	return DataCall{
+		request:           request,
+		responseReadLater: true,
+	}
+}
+
+// NewResponseFromData sends no request and reads the response to an earlier call from NewFromDataWithoutResponse.
+func NewResponseFromData(expectedResponse protocol.Data) DataCall {
+	return DataCall{
+		expectedResponse: expectedResponse,
+		responseOnly:     true,
+	}
+}
+
 type DataCall struct {
 	request              []protocol.Data
 	expectedResponse     protocol.Data
 	expectedPartialError string
 	callIsNotAnError     bool
+	responseReadLater    bool
+	responseOnly         bool
 	delay                time.Duration
 }
 
@@ -52,6 +71,10 @@ func (c DataCall) RequestData() protocol.Data {
 }
 
 func (c DataCall) Request() string {
+	if c.responseOnly {
+		return ""
+	}
+
 	var buffer bytes.Buffer
 	err := protocol.WriteData(&buffer, c.RequestData())
 
@@ -62,7 +85,7 @@ func (c DataCall) Request() string {
 }
 
 func (c DataCall) IsResponseExpected() bool {
-	return true
+	return !c.responseReadLater
 }
 
 func (c DataCall) ConfirmResponse(t testing.TB, response string) {
@@ -0,0 +1,122 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestAppendCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"append to a missing key creates the string": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("Hello"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewBulkString("Hello"),
+				),
+			},
+		},
+		"append to a string adds to its end": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("Hello"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString(" World"),
+					},
+					protocol.NewSimpleInteger(11),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewBulkString("Hello World"),
+				),
+			},
+		},
+		"append to a string keeps its expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("Hello"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("1000"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString(" World"),
+					},
+					protocol.NewSimpleInteger(11),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(time.Second),
+			},
+		},
+		"append to a key holding a list is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
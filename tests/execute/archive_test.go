@@ -98,6 +98,1664 @@ func TestWritingToArchive(t *testing.T) {
 				),
 			},
 		},
+		"getting hash fields that have been set, incremented and deleted": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-with-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("f3"),
+						protocol.NewBulkString("v3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-with-hash" + uniqueSuffix),
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("0.5"),
+					},
+					protocol.NewBulkString("10.5"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HDEL"),
+						protocol.NewBulkString("key-with-hash" + uniqueSuffix),
+						protocol.NewBulkString("f3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGETALL"),
+						protocol.NewBulkString("key-with-hash" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("10.5"),
+					}),
+				),
+			},
+		},
+		"getting set members that have been added, popped and moved": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-with-set" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key-with-set" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+					},
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-with-set" + uniqueSuffix),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMOVE"),
+						protocol.NewBulkString("key-with-set" + uniqueSuffix),
+						protocol.NewBulkString("key-with-moved-member" + uniqueSuffix),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-with-set" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMEMBERS"),
+						protocol.NewBulkString("key-with-moved-member" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m4"),
+					}),
+				),
+			},
+		},
+		"getting sets that have been stored from set algebra": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-with-set-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-with-set-b" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERSTORE"),
+						protocol.NewBulkString("key-with-intersection" + uniqueSuffix),
+						protocol.NewBulkString("key-with-set-a" + uniqueSuffix),
+						protocol.NewBulkString("key-with-set-b" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+						protocol.NewBulkString("key-with-difference" + uniqueSuffix),
+						protocol.NewBulkString("key-with-set-a" + uniqueSuffix),
+						protocol.NewBulkString("key-with-set-b" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-with-intersection" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMEMBERS"),
+						protocol.NewBulkString("key-with-difference" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m1"),
+					}),
+				),
+			},
+		},
+		"getting sorted set scores that have been added, incremented and removed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("2.5"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("3.5"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREM"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("WITHSCORE"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("3.5"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewBulkString("2"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCARD"),
+						protocol.NewBulkString("key-with-sorted-set" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+			},
+		},
+		"getting sorted set ranges that have been stored and removed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-with-ranked-set" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("key-with-stored-range" + uniqueSuffix),
+						protocol.NewBulkString("key-with-ranked-set" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("+inf"),
+						protocol.NewBulkString("BYSCORE"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYRANK"),
+						protocol.NewBulkString("key-with-stored-range" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-with-stored-range" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("e"),
+						protocol.NewBulkString("5"),
+					}),
+				),
+			},
+		},
+		"getting sorted sets that have been aggregated and popped": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-with-daily-scores" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-with-other-daily-scores" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZUNIONSTORE"),
+						protocol.NewBulkString("key-with-weekly-scores" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-with-daily-scores" + uniqueSuffix),
+						protocol.NewBulkString("key-with-other-daily-scores" + uniqueSuffix),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZPOPMIN"),
+						protocol.NewBulkString("key-with-weekly-scores" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-with-missing-scores" + uniqueSuffix),
+						protocol.NewBulkString("key-with-weekly-scores" + uniqueSuffix),
+						protocol.NewBulkString("MAX"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-with-weekly-scores" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("m2"),
+								protocol.NewBulkString("9"),
+							}),
+						}),
+					}),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-with-weekly-scores" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("6"),
+					}),
+				),
+			},
+		},
+		"getting lists that have been popped and edited": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+					},
+					protocol.NewBulkString("a"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("e"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSET"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("x"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("AFTER"),
+						protocol.NewBulkString("x"),
+						protocol.NewBulkString("y"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LTRIM"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-with-queue" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("x"),
+						protocol.NewBulkString("y"),
+					}),
+				),
+			},
+		},
+		"getting lists that have been popped and moved by blocking commands": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("e"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("key-with-work" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewBulkString("b"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("d"),
+						}),
+					}),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-with-jobs" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-with-work" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+					}),
+				),
+			},
+		},
+		"getting lists that have been moved between pending and in progress": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewBulkString("key-in-progress" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewBulkString("a"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewBulkString("key-in-progress" + uniqueSuffix),
+					},
+					protocol.NewBulkString("d"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("key-in-progress" + uniqueSuffix),
+						protocol.NewBulkString("key-in-progress" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewBulkString("d"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("c"),
+						}),
+					}),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-pending" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-in-progress" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("d"),
+					}),
+				),
+			},
+		},
+		"getting strings that have been appended and overwritten": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-with-log" + uniqueSuffix),
+						protocol.NewBulkString("first line,"),
+					},
+					protocol.NewSimpleInteger(11),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-with-log" + uniqueSuffix),
+						protocol.NewBulkString("second line"),
+					},
+					protocol.NewSimpleInteger(22),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key-with-log" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("FIRST"),
+					},
+					protocol.NewSimpleInteger(22),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key-with-log" + uniqueSuffix),
+						protocol.NewBulkString("24"),
+						protocol.NewBulkString("!"),
+					},
+					protocol.NewSimpleInteger(25),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-with-log" + uniqueSuffix),
+					},
+					protocol.NewBulkString("FIRST line,second line\u0000\u0000!"),
+				),
+			},
+		},
+		"getting strings set together": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key-third" + uniqueSuffix),
+						protocol.NewBulkString("three"),
+						protocol.NewBulkString("key-fourth" + uniqueSuffix),
+						protocol.NewBulkString("four"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("uno"),
+						protocol.NewBulkString("key-fifth" + uniqueSuffix),
+						protocol.NewBulkString("five"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MGET"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("key-third" + uniqueSuffix),
+						protocol.NewBulkString("key-fourth" + uniqueSuffix),
+						protocol.NewBulkString("key-fifth" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("two"),
+						protocol.NewBulkString("three"),
+						protocol.NewBulkString("four"),
+						nil,
+					}),
+				),
+			},
+		},
+		"getting numbers that have been incremented by amounts": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-counter" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleInteger(10),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-counter" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleInteger(7),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+						protocol.NewBulkString("0.1"),
+					},
+					protocol.NewBulkString("0.1"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+						protocol.NewBulkString("0.2"),
+					},
+					protocol.NewBulkString("0.3"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-counter" + uniqueSuffix),
+					},
+					protocol.NewBulkString("7"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+					},
+					protocol.NewBulkString("0.3"),
+				),
+			},
+		},
+		"getting a number with an expiry that has been incremented": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-counter-with-expiry" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("key-counter-with-expiry" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(6),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-counter-with-expiry" + uniqueSuffix),
+					},
+					protocol.NewBulkString("6"),
+				),
+			},
+		},
+		"getting keys with expiries set after they were written": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list-expiring" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRE"),
+						protocol.NewBulkString("key-list-expiring" + uniqueSuffix),
+						protocol.NewBulkString("1000"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expired" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key-expired" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			delayBeforeRestore: time.Second,
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("key-list-expiring" + uniqueSuffix),
+						protocol.NewBulkString("key-expired" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"getting a key whose expiry has been removed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-persisted" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PERSIST"),
+						protocol.NewBulkString("key-persisted" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-persisted" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"getting strings set with legacy commands": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETNX"),
+						protocol.NewBulkString("key-setnx" + uniqueSuffix),
+						protocol.NewBulkString("first"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETNX"),
+						protocol.NewBulkString("key-setnx" + uniqueSuffix),
+						protocol.NewBulkString("second"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-getset" + uniqueSuffix),
+						protocol.NewBulkString("first"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-getset" + uniqueSuffix),
+						protocol.NewBulkString("second"),
+					},
+					protocol.NewBulkString("first"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key-setex" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-getdel" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETDEL"),
+						protocol.NewBulkString("key-getdel" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+						protocol.NewBulkString("PERSIST"),
+					},
+					protocol.NewBulkString("value"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MGET"),
+						protocol.NewBulkString("key-setnx" + uniqueSuffix),
+						protocol.NewBulkString("key-getset" + uniqueSuffix),
+						protocol.NewBulkString("key-setex" + uniqueSuffix),
+						protocol.NewBulkString("key-getdel" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("first"),
+						protocol.NewBulkString("second"),
+						protocol.NewBulkString("value"),
+						nil,
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-setex" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"getting strings that expired with relative expiries": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key-psetex" + uniqueSuffix),
+						protocol.NewBulkString("1000"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewBulkString("value"),
+				),
+			},
+			delayBeforeRestore: time.Second,
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-psetex" + uniqueSuffix),
+						protocol.NewBulkString("key-getex" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"getting keys that have been renamed and copied": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-renamed" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+						protocol.NewBulkString("key-renamed" + uniqueSuffix),
+						protocol.NewBulkString("key-new-name" + uniqueSuffix),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("key-list-copy" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list-copy" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-unlinked" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("UNLINK"),
+						protocol.NewBulkString("key-unlinked" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-new-name" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-renamed" + uniqueSuffix),
+						protocol.NewBulkString("key-unlinked" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LLEN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LLEN"),
+						protocol.NewBulkString("key-list-copy" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+			},
+		},
+		"getting values set in another database": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-in-database" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-in-database" + uniqueSuffix),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-database" + uniqueSuffix),
+					},
+					protocol.NewBulkString("zero"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-database" + uniqueSuffix),
+					},
+					protocol.NewBulkString("one"),
+				),
+			},
+		},
+		"getting values deleted on expiry in another database": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expired-in-database" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expired-in-database" + uniqueSuffix),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-expired-in-database" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(100 * time.Millisecond),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-expired-in-database" + uniqueSuffix),
+					},
+					protocol.NewBulkString("zero"),
+				),
+			},
+		},
+		"getting values moved and swapped between databases": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-moved" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MOVE"),
+						protocol.NewBulkString("key-moved" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-flushed" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHDB"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-moved" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-moved" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DBSIZE"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"getting values set in a transaction": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleString("OK"),
+						protocol.NewSimpleString("OK"),
+						protocol.NewSimpleString("OK"),
+						protocol.NewBulkString("one"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-after-transaction" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewBulkString("zero"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewBulkString("one"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-after-transaction" + uniqueSuffix),
+					},
+					protocol.NewBulkString("one"),
+				),
+			},
+		},
+		"getting a set stored into a key with an expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-union-source" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SUNIONSTORE"),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+						protocol.NewBulkString("key-union-source" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-stored-union" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"getting values moved out of keys with an expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list-source" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-list-source" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("key-list-source" + uniqueSuffix),
+						protocol.NewBulkString("key-list-destination" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewBulkString("a"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-set-source" + uniqueSuffix),
+						protocol.NewBulkString("m"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-set-source" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMOVE"),
+						protocol.NewBulkString("key-set-source" + uniqueSuffix),
+						protocol.NewBulkString("key-set-destination" + uniqueSuffix),
+						protocol.NewBulkString("m"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-list-destination" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-list-source" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMEMBERS"),
+						protocol.NewBulkString("key-set-destination" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-set-source" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"getting strings set with legacy expiry commands in a transaction": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key-setex-in-transaction" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key-psetex-in-transaction" + uniqueSuffix),
+						protocol.NewBulkString("10000"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleString("OK"),
+						protocol.NewSimpleString("OK"),
+					}),
+				).WithDelay(5 * time.Second),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PTTL"),
+						protocol.NewBulkString("key-setex-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(10000),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PTTL"),
+						protocol.NewBulkString("key-psetex-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(10000),
+				),
+			},
+			postRestoreCalls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PTTL"),
+						protocol.NewBulkString("key-setex-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(10000),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PTTL"),
+						protocol.NewBulkString("key-psetex-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(10000),
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -111,7 +1769,8 @@ func TestWritingToArchive(t *testing.T) {
 			// When a server is restored after a delay
 			clock.AddMilliseconds(testCase.delayBeforeRestore.Milliseconds())
 
-			restoredServer, err := server.NewChallengeServer(0, store.NewBuilder().WithClock(clock)).
+			restoredServer, err := server.NewChallengeServer(0, store.NewBuilder()).
+				WithClock(clock).
 				RestoreFromArchive(buffer).
 				Start()
 			require.NoError(t, err)
@@ -121,3 +1780,33 @@ func TestWritingToArchive(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoringIncompleteTransactionFromArchive(t *testing.T) {
+	// Given a log ending part way through a transaction, as when the server stopped while writing it
+	archive := bytes.NewBufferString("*3\r\n$3\r\nSET\r\n$6\r\nbefore\r\n$5\r\nvalue\r\n" +
+		"*1\r\n$5\r\nMULTI\r\n*3\r\n$3\r\nSET\r\n$6\r\nwithin\r\n$5\r\nvalue\r\n")
+
+	// When a server is restored from it
+	restoredServer, err := server.NewChallengeServer(0, store.NewBuilder()).
+		RestoreFromArchive(archive).
+		Start()
+	require.NoError(t, err)
+
+	// Then none of the transaction is applied
+	tests.SendCallsToServer(t, restoredServer, []call.DataCall{
+		call.NewFromData(
+			[]protocol.Data{
+				protocol.NewBulkString("GET"),
+				protocol.NewBulkString("before"),
+			},
+			protocol.NewBulkString("value"),
+		),
+		call.NewFromData(
+			[]protocol.Data{
+				protocol.NewBulkString("GET"),
+				protocol.NewBulkString("within"),
+			},
+			nil,
+		),
+	}, tests.UseChallengeServer, &store.FixedClock{TimeInMilliseconds: 1_000})
+}
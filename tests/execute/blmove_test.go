@@ -0,0 +1,179 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestBLMoveCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"blmove moves a value from the end of the source to the start of the destination": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("x"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewBulkString("c"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("x"),
+					}),
+				),
+			},
+		},
+		"blmove to the same key rotates the list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-rotate" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("key-rotate" + uniqueSuffix),
+						protocol.NewBulkString("key-rotate" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewBulkString("a"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-rotate" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("a"),
+					}),
+				),
+			},
+		},
+		"blmove to a destination holding a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+			},
+		},
+		"blmove times out when no value is pushed": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutResponse(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("0.5"),
+					},
+				),
+				call.NewResponseFromData(nil).WithDelay(time.Second),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestBLMPopCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"blmpop pops up to count values from the first key holding a list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+					}),
+				),
+			},
+		},
+		"blmpop from the right with a count beyond the length pops every value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("b"),
+							protocol.NewBulkString("a"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"blmpop of a key holding a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+		"blmpop times out when no value is pushed": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutResponse(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0.5"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+					},
+				),
+				call.NewResponseFromData(protocol.NewNullArray()).WithDelay(time.Second),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
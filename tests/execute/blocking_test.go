@@ -0,0 +1,550 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestBlockingCommands(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"blpop waits for a value pushed by another connection": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("EXISTS"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(0),
+					),
+				},
+			},
+		},
+		"connections waiting on a key are served in the order they blocked": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BRPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+							protocol.NewBulkString("c"),
+						},
+						protocol.NewSimpleInteger(3),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+				{
+					Connection: 1,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("c"),
+					})),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LRANGE"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("-1"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("b"),
+						}),
+					),
+				},
+			},
+		},
+		"a single value serves only the longest waiting connection": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("b"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					})),
+				},
+			},
+		},
+		"blpop on several keys is served from the key pushed": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-first" + uniqueSuffix),
+							protocol.NewBulkString("key-second" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LPUSH"),
+							protocol.NewBulkString("key-second" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						},
+						protocol.NewSimpleInteger(2),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					})),
+				},
+			},
+		},
+		"other connections are served while a connection waits": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key-other" + uniqueSuffix),
+							protocol.NewBulkString("value"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key-other" + uniqueSuffix),
+						},
+						protocol.NewBulkString("value"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+			},
+		},
+		"a key set to a string keeps the connection waiting": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("value"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("DEL"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-queue" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+			},
+		},
+		"a waiting connection times out without taking later values": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("0.5"),
+						},
+					),
+				},
+				{
+					Connection: 0,
+					Call:       call.NewResponseFromData(protocol.NewNullArray()).WithDelay(time.Second),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LLEN"),
+							protocol.NewBulkString("key-queue" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+			},
+		},
+		"blmove waits and pushes the value to the destination": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLMOVE"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("key-destination" + uniqueSuffix),
+							protocol.NewBulkString("RIGHT"),
+							protocol.NewBulkString("LEFT"),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						},
+						protocol.NewSimpleInteger(2),
+					),
+				},
+				{
+					Connection: 0,
+					Call:       call.NewResponseFromData(protocol.NewBulkString("b")),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LRANGE"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("-1"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+						}),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LRANGE"),
+							protocol.NewBulkString("key-destination" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("-1"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("b"),
+						}),
+					),
+				},
+			},
+		},
+		"a value moved by blmove serves a connection waiting on the destination": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("key-destination" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLMOVE"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("key-destination" + uniqueSuffix),
+							protocol.NewBulkString("LEFT"),
+							protocol.NewBulkString("RIGHT"),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 1,
+					Call:       call.NewResponseFromData(protocol.NewBulkString("a")),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+				{
+					Connection: 2,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("EXISTS"),
+							protocol.NewBulkString("key-source" + uniqueSuffix),
+							protocol.NewBulkString("key-destination" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(0),
+					),
+				},
+			},
+		},
+		"blmpop waits and pops up to count values": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLMPOP"),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("2"),
+							protocol.NewBulkString("key-first" + uniqueSuffix),
+							protocol.NewBulkString("key-second" + uniqueSuffix),
+							protocol.NewBulkString("LEFT"),
+							protocol.NewBulkString("COUNT"),
+							protocol.NewBulkString("2"),
+						},
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("key-second" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+							protocol.NewBulkString("c"),
+						},
+						protocol.NewSimpleInteger(3),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						}),
+					})),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LRANGE"),
+							protocol.NewBulkString("key-second" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("-1"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("c"),
+						}),
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestBLPopCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"blpop pops from the first key holding a list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+					}),
+				),
+			},
+		},
+		"blpop of the last value removes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"blpop of a key holding a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+		"blpop times out when no value is pushed": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutResponse(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0.5"),
+					},
+				),
+				call.NewResponseFromData(protocol.NewNullArray()).WithDelay(time.Second),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
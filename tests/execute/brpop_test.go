@@ -0,0 +1,95 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestBRPopCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"brpop pops from the end of the first key holding a list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+			},
+		},
+		"brpop of a key holding a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+		"brpop times out when no value is pushed": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutResponse(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0.25"),
+					},
+				),
+				call.NewResponseFromData(protocol.NewNullArray()).WithDelay(time.Second),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
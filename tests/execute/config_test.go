@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	"fmt"
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfig(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	bulk := func(text string) string {
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(text), text)
+	}
+	request := func(arguments ...string) string {
+		var builder strings.Builder
+		builder.WriteString(fmt.Sprintf("*%d\r\n", len(arguments)))
+		for _, argument := range arguments {
+			builder.WriteString(bulk(argument))
+		}
+		return builder.String()
+	}
+	push := func(parts ...string) string {
+		return fmt.Sprintf("*%d\r\n%s", len(parts), strings.Join(parts, ""))
+	}
+	count := func(n int) string {
+		return fmt.Sprintf(":%d\r\n", n)
+	}
+	on := func(connection int, c call.Call) tests.ConnectionCall {
+		return tests.ConnectionCall{Connection: connection, Call: c}
+	}
+	receive := func(connection int, expected string) tests.ConnectionCall {
+		return on(connection, call.NewFromProtocol("", expected))
+	}
+	configure := func(connection int, flags string) tests.ConnectionCall {
+		return on(connection, call.NewFromProtocol(request("CONFIG", "SET", "notify-keyspace-events", flags), "+OK\r\n"))
+	}
+
+	key := "key" + uniqueSuffix
+	list := "list" + uniqueSuffix
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"config get reports the flags of notify-keyspace-events": {
+			calls: []tests.ConnectionCall{
+				configure(0, "KEA"),
+				on(0, call.NewFromProtocol(request("CONFIG", "GET", "notify-keyspace-*"), push(bulk("notify-keyspace-events"), bulk("AKE")))),
+				configure(0, "lgK"),
+				on(0, call.NewFromProtocol(request("CONFIG", "GET", "NOTIFY-KEYSPACE-EVENTS"), push(bulk("notify-keyspace-events"), bulk("glK")))),
+				configure(0, ""),
+				on(0, call.NewFromProtocol(request("CONFIG", "GET", "notify-keyspace-events"), push(bulk("notify-keyspace-events"), bulk("")))),
+			},
+		},
+		"config set with an unknown event class is an error that keeps the flags": {
+			calls: []tests.ConnectionCall{
+				configure(0, "Kg"),
+				on(0, call.NewFromProtocol(request("CONFIG", "SET", "notify-keyspace-events", "KgZ"),
+					"-ERR CONFIG SET failed (possibly related to argument 'notify-keyspace-events') - Invalid event class character. Use 'Ag$lshzxeKEtmdn'.\r\n")),
+				on(0, call.NewFromProtocol(request("CONFIG", "GET", "notify-keyspace-events"), push(bulk("notify-keyspace-events"), bulk("gK")))),
+				configure(0, ""),
+			},
+		},
+		"a key event is received by the subscriber of its keyevent channel": {
+			calls: []tests.ConnectionCall{
+				configure(0, "E$"),
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", "__keyevent@0__:set"), push(bulk("subscribe"), bulk("__keyevent@0__:set"), count(1)))),
+				on(1, call.NewFromProtocol(request("SET", key, "value"), "+OK\r\n")),
+				receive(0, push(bulk("message"), bulk("__keyevent@0__:set"), bulk(key))),
+				configure(1, ""),
+			},
+		},
+		"key events are received by the subscriber of a pattern matching the keyspace channel of the key": {
+			calls: []tests.ConnectionCall{
+				configure(0, "KA"),
+				on(0, call.NewFromProtocol(request("PSUBSCRIBE", "__keyspace@0__:*"+uniqueSuffix), push(bulk("psubscribe"), bulk("__keyspace@0__:*"+uniqueSuffix), count(1)))),
+				on(1, call.NewFromProtocol(request("RPUSH", list, "a", "b"), count(2))),
+				receive(0, push(bulk("pmessage"), bulk("__keyspace@0__:*"+uniqueSuffix), bulk("__keyspace@0__:"+list), bulk("rpush"))),
+				on(1, call.NewFromProtocol(request("LPOP", list, "2"), push(bulk("a"), bulk("b")))),
+				receive(0, push(bulk("pmessage"), bulk("__keyspace@0__:*"+uniqueSuffix), bulk("__keyspace@0__:"+list), bulk("lpop"))+
+					push(bulk("pmessage"), bulk("__keyspace@0__:*"+uniqueSuffix), bulk("__keyspace@0__:"+list), bulk("del"))),
+				configure(1, ""),
+			},
+		},
+		"a key expiring is received as an expired event": {
+			calls: []tests.ConnectionCall{
+				configure(0, "Ex"),
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", "__keyevent@0__:expired"), push(bulk("subscribe"), bulk("__keyevent@0__:expired"), count(1)))),
+				on(1, call.NewFromProtocol(request("SET", key, "value", "PX", "1"), "+OK\r\n")),
+				on(1, call.NewFromData([]protocol.Data{protocol.NewBulkString("GET"), protocol.NewBulkString(key)}, nil).
+					WithDelay(2*time.Millisecond)),
+				receive(0, push(bulk("message"), bulk("__keyevent@0__:expired"), bulk(key))),
+				configure(1, ""),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestCopyCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"copy duplicates the value and expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MGET"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("value"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+			},
+		},
+		"copy of a collection is independent of the source": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+			},
+		},
+		"copy does not replace an existing key without replace": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("REPLACE"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+			},
+		},
+		"copy of a missing key does nothing": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"copy to a database out of range is not allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("DB"),
+						protocol.NewBulkString("16"),
+					},
+					protocol.NewSimpleError("ERR DB index is out of range"),
+				),
+			},
+		},
+		"copy to another database keeps the key in the selected database": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+						protocol.NewBulkString("DB"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-source" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-destination" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+			},
+		},
+		"copy to the same key in another database is allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("DB"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key" + uniqueSuffix),
+					},
+					protocol.NewBulkString("zero"),
+				),
+			},
+		},
+		"copy to the same key in the selected database is not allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("key" + uniqueSuffix),
+						protocol.NewBulkString("DB"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR source and destination objects are the same"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
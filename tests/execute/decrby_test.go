@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestDecrByCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"decrby subtracts the decrement from the integer": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleInteger(7),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("-3"),
+					},
+					protocol.NewSimpleInteger(10),
+				),
+			},
+		},
+		"decrby of a missing key starts from zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("7"),
+					},
+					protocol.NewSimpleInteger(-7),
+				),
+			},
+		},
+		"decrby past the smallest integer would overflow": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-smallest" + uniqueSuffix),
+						protocol.NewBulkString("-9223372036854775807"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-smallest" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(-9223372036854775808),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key-smallest" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR increment or decrement would overflow"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,510 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestExpireCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"expire sets a relative expiry in seconds": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(time.Second),
+			},
+		},
+		"pexpire sets a relative expiry in milliseconds": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("1500"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				).WithDelay(1500 * time.Millisecond),
+			},
+		},
+		"expireat and pexpireat set an absolute expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-seconds" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-milliseconds" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key-seconds" + uniqueSuffix),
+						protocol.NewBulkString("4102444800"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key-milliseconds" + uniqueSuffix),
+						protocol.NewBulkString("4102444800123"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRETIME"),
+						protocol.NewBulkString("key-seconds" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(4102444800),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRETIME"),
+						protocol.NewBulkString("key-milliseconds" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(4102444800123),
+				),
+			},
+		},
+		"expire of a missing key does nothing": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"expire in the past deletes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"expire works on every type of value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				).WithDelay(time.Second),
+			},
+		},
+		"pushing to a list keeps its expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LLEN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				).WithDelay(time.Second),
+			},
+		},
+		"expire with nx only sets an expiry on a key without one": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("NX"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("200"),
+						protocol.NewBulkString("NX"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+			},
+		},
+		"expire with xx only changes an existing expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("XX"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("200"),
+						protocol.NewBulkString("xx"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(200),
+				),
+			},
+		},
+		"expire with gt only increases an expiry and never applies to a key without one": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("GT"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("50"),
+						protocol.NewBulkString("GT"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("200"),
+						protocol.NewBulkString("GT"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(200),
+				),
+			},
+		},
+		"expire with lt only decreases an expiry and always applies to a key without one": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("LT"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("200"),
+						protocol.NewBulkString("LT"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("50"),
+						protocol.NewBulkString("LT"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(50),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
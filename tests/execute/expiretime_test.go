@@ -0,0 +1,98 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestExpireTimeCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"expiretime of a missing key is minus two": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRETIME"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRETIME"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-2),
+				),
+			},
+		},
+		"expiretime of a key without an expiry is minus one": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRETIME"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRETIME"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"expiretime of a key is the absolute time it expires": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("PXAT"),
+						protocol.NewBulkString("4102444800600"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIRETIME"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(4102444801),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRETIME"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(4102444800600),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
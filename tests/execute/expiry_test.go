@@ -38,6 +38,41 @@ func TestExpiry(t *testing.T) {
 				).WithDelay(time.Second + time.Millisecond),
 			},
 		},
+		"incrementing and decrementing a value with expiry should still expire": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-counter-expired" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("1000"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("key-counter-expired" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(6),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECR"),
+						protocol.NewBulkString("key-counter-expired" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-counter-expired" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				).WithDelay(time.Second + time.Millisecond),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,218 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestGetExCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"getex without options returns the value and leaves the expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(100),
+				),
+			},
+		},
+		"getex with ex refreshes the expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(time.Second),
+			},
+		},
+		"getex with pxat sets an absolute expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("PXAT"),
+						protocol.NewBulkString("4102444800123"),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRETIME"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(4102444800123),
+				),
+			},
+		},
+		"getex with exat in the past deletes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("EXAT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"getex with persist removes the expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("PERSIST"),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"getex of a missing key is nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("100"),
+					},
+					nil,
+				),
+			},
+		},
+		"getex of a key holding a set is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("TTL"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
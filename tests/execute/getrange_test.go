@@ -0,0 +1,157 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestGetRangeCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"getrange reads inclusive ranges counting from either end": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("This is a string"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewBulkString("This"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("-3"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewBulkString("ing"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewBulkString("This is a string"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewBulkString("string"),
+				),
+			},
+		},
+		"getrange clamps ranges outside the string": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("Hello"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("-100"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewBulkString("He"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewBulkString(""),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewBulkString(""),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("-3"),
+					},
+					protocol.NewBulkString(""),
+				),
+			},
+		},
+		"getrange of a missing key is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewBulkString(""),
+				),
+			},
+		},
+		"getrange of a key holding a hash is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
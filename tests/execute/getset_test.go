@@ -0,0 +1,129 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestGetSetCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"getset replaces the value and returns the old one": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("old"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					protocol.NewBulkString("old"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewBulkString("new"),
+				),
+			},
+		},
+		"getset of a missing key sets it and returns nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewBulkString("new"),
+				),
+			},
+		},
+		"getset removes any expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("old"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					protocol.NewBulkString("old"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+					},
+					protocol.NewBulkString("new"),
+				).WithDelay(time.Second),
+			},
+		},
+		"getset of a key holding a list is the wrong type and leaves the list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETSET"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LLEN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
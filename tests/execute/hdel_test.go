@@ -0,0 +1,122 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHDelCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hdel removes existing fields and returns the count removed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("v2"),
+						protocol.NewBulkString("f3"),
+						protocol.NewBulkString("v3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HDEL"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("missing"),
+						protocol.NewBulkString("f3"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HKEYS"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("f2"),
+					}),
+				),
+			},
+		},
+		"hdel of the last field removes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HDEL"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-last" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"hdel of a missing key removes nothing": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HDEL"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"hdel of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HDEL"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package command_test
+
+import (
+	"fmt"
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHelloCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	missingKey := "missing-key" + uniqueSuffix
+	getMissingKey := fmt.Sprintf("*2\r\n$3\r\nGET\r\n$%d\r\n%s\r\n", len(missingKey), missingKey)
+
+	testCases := map[string]struct {
+		calls        []call.Call
+		driverChoice tests.ServerVariant
+	}{
+		"hello without a protocol version replies with server details using the RESP2 protocol": {
+			calls: []call.Call{
+				call.NewFromProtocolWithPartialResponse(
+					"*1\r\n$5\r\nHELLO\r\n",
+					"*14\r\n$6\r\nserver\r\n$5\r\nredis\r\n$7\r\nversion\r\n",
+				),
+			},
+		},
+		"hello with protocol version 3 replies with server details as a map": {
+			calls: []call.Call{
+				call.NewFromProtocolWithPartialResponse(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n3\r\n",
+					"%7\r\n$6\r\nserver\r\n$5\r\nredis\r\n$7\r\nversion\r\n",
+				),
+			},
+		},
+		"hello with protocol version 3 includes the protocol version in the reply": {
+			calls: []call.Call{
+				call.NewFromProtocolWithPartialResponse(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n3\r\n",
+					"$5\r\nproto\r\n:3\r\n",
+				),
+			},
+		},
+		"getting a missing key before hello replies with a RESP2 null": {
+			calls: []call.Call{
+				call.NewFromProtocol(getMissingKey, "$-1\r\n"),
+			},
+		},
+		"getting a missing key after hello with protocol version 3 replies with a RESP3 null": {
+			calls: []call.Call{
+				call.NewFromProtocolWithPartialResponse(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n3\r\n",
+					"%7\r\n",
+				),
+				call.NewFromProtocol(getMissingKey, "_\r\n"),
+			},
+		},
+		"getting a missing key after switching back to protocol version 2 replies with a RESP2 null": {
+			calls: []call.Call{
+				call.NewFromProtocolWithPartialResponse(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n3\r\n",
+					"%7\r\n",
+				),
+				call.NewFromProtocolWithPartialResponse(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n2\r\n",
+					"*14\r\n",
+				),
+				call.NewFromProtocol(getMissingKey, "$-1\r\n"),
+			},
+		},
+		"hello with an unsupported protocol version keeps the current protocol": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"*2\r\n$5\r\nHELLO\r\n$1\r\n4\r\n",
+					"-NOPROTO unsupported protocol version\r\n",
+				),
+				call.NewFromProtocol(getMissingKey, "$-1\r\n"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
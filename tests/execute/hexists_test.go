@@ -0,0 +1,80 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHExistsCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hexists of an existing field is 1": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HEXISTS"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+		},
+		"hexists of a missing field is 0": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-missing-field" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HEXISTS"),
+						protocol.NewBulkString("key-missing-field" + uniqueSuffix),
+						protocol.NewBulkString("f2"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"hexists of a missing key is 0": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HEXISTS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHGetAllCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hgetall returns fields and values in the order the fields were added": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGETALL"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("3"),
+					}),
+				),
+			},
+		},
+		"hgetall of a missing key is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGETALL"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"hgetall of a key with a list value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGETALL"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHIncrByCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hincrby of a missing field sets it to the increment": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGET"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewBulkString("5"),
+				),
+			},
+		},
+		"hincrby of an integer field adds the increment": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("-15"),
+					},
+					protocol.NewSimpleInteger(-5),
+				),
+			},
+		},
+		"hincrby of a non-integer field is an error and leaves the value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("text"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR hash value is not an integer"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGET"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewBulkString("text"),
+				),
+			},
+		},
+		"hincrby that would overflow is an error and leaves the value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-overflow" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("9223372036854775800"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key-overflow" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleError("ERR increment or decrement would overflow"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HGET"),
+						protocol.NewBulkString("key-overflow" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+					},
+					protocol.NewBulkString("9223372036854775800"),
+				),
+			},
+		},
+		"hincrby of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
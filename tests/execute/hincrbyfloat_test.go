@@ -0,0 +1,134 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHIncrByFloatCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hincrbyfloat of a missing field sets it to the increment": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("10.5"),
+					},
+					protocol.NewBulkString("10.5"),
+				),
+			},
+		},
+		"hincrbyfloat adds the increment without binary rounding surprises": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("10.50"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("0.1"),
+					},
+					protocol.NewBulkString("10.6"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("-5"),
+					},
+					protocol.NewBulkString("5.6"),
+				),
+			},
+		},
+		"hincrbyfloat with exponents is formatted without exponents": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-exponent" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("5.0e3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-exponent" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("2.0e2"),
+					},
+					protocol.NewBulkString("5200"),
+				),
+			},
+		},
+		"hincrbyfloat of a non-float field is an error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("text"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR hash value is not a float"),
+				),
+			},
+		},
+		"hincrbyfloat producing infinity is an error and does not create the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key-infinite" + uniqueSuffix),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("inf"),
+					},
+					protocol.NewSimpleError("ERR increment would produce NaN or Infinity"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-infinite" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHKeysCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hkeys returns the fields in the order they were added": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("v2"),
+						protocol.NewBulkString("f1"),
+						protocol.NewBulkString("v1"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HKEYS"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("f2"),
+						protocol.NewBulkString("f1"),
+					}),
+				),
+			},
+		},
+		"hkeys of a missing key is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HKEYS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHScanCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"hscan of a small hash returns every field with its value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSCAN"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("field"),
+							protocol.NewBulkString("value"),
+						}),
+					}),
+				),
+			},
+		},
+		"hscan leaves out fields not matching the pattern": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("other"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSCAN"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("f*"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("field"),
+							protocol.NewBulkString("value"),
+						}),
+					}),
+				),
+			},
+		},
+		"hscan of a missing key finishes at once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSCAN"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				),
+			},
+		},
+		"hscan of a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSCAN"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
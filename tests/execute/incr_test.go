@@ -6,6 +6,7 @@ import (
 	"redis-challenge/tests"
 	"redis-challenge/tests/call"
 	"testing"
+	"time"
 )
 
 func TestIncrCommand(t *testing.T) {
@@ -112,6 +113,41 @@ func TestIncrCommand(t *testing.T) {
 				),
 			},
 		},
+		"incrementing a key with an expiry should keep the expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-with-expiry-to-increment" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("key-with-expiry-to-increment" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-with-expiry-to-increment" + uniqueSuffix),
+					},
+					protocol.NewBulkString("1"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-with-expiry-to-increment" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(time.Second + time.Millisecond),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,149 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestIncrByCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"incrby adds the increment to the integer": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleInteger(15),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+						protocol.NewBulkString("-20"),
+					},
+					protocol.NewSimpleInteger(-5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-integer" + uniqueSuffix),
+					},
+					protocol.NewBulkString("-5"),
+				),
+			},
+		},
+		"incrby of a missing key starts from zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("7"),
+					},
+					protocol.NewSimpleInteger(7),
+				),
+			},
+		},
+		"incrby past the largest integer would overflow and leaves the value": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-largest" + uniqueSuffix),
+						protocol.NewBulkString("9223372036854775806"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-largest" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(9223372036854775807),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-largest" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR increment or decrement would overflow"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-largest" + uniqueSuffix),
+					},
+					protocol.NewBulkString("9223372036854775807"),
+				),
+			},
+		},
+		"incrby of a value that is not an integer is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"incrby of a key holding a hash is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HSET"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBY"),
+						protocol.NewBulkString("key-hash" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestIncrByFloatCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"incrbyfloat adds the increment to the number": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+						protocol.NewBulkString("10.50"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+						protocol.NewBulkString("0.1"),
+					},
+					protocol.NewBulkString("10.6"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+						protocol.NewBulkString("-5"),
+					},
+					protocol.NewBulkString("5.6"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-float" + uniqueSuffix),
+					},
+					protocol.NewBulkString("5.6"),
+				),
+			},
+		},
+		"incrbyfloat accepts exponents and formats without them": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-exponent" + uniqueSuffix),
+						protocol.NewBulkString("5.0e3"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-exponent" + uniqueSuffix),
+						protocol.NewBulkString("2.0e2"),
+					},
+					protocol.NewBulkString("5200"),
+				),
+			},
+		},
+		"incrbyfloat of a missing key starts from zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("3.0e-1"),
+					},
+					protocol.NewBulkString("0.3"),
+				),
+			},
+		},
+		"incrbyfloat keeps the expiry": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("1000"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("1.5"),
+					},
+					protocol.NewBulkString("2.5"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+					},
+					nil,
+				).WithDelay(time.Second),
+			},
+		},
+		"incrbyfloat of a value that is not a float is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-text" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+		"incrbyfloat to infinity is not allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-infinite" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key-infinite" + uniqueSuffix),
+						protocol.NewBulkString("inf"),
+					},
+					protocol.NewSimpleError("ERR increment would produce NaN or Infinity"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-infinite" + uniqueSuffix),
+					},
+					protocol.NewBulkString("1"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLcsCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"lcs is the longest common subsequence of two strings": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("ohmytext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("mynewtext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+					},
+					protocol.NewBulkString("mytext"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("LEN"),
+					},
+					protocol.NewSimpleInteger(6),
+				),
+			},
+		},
+		"lcs with idx has the matching ranges from the end of the strings": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("ohmytext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("mynewtext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("IDX"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("matches"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(4),
+									protocol.NewSimpleInteger(7),
+								}),
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(5),
+									protocol.NewSimpleInteger(8),
+								}),
+							}),
+							protocol.NewArray([]protocol.Data{
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(2),
+									protocol.NewSimpleInteger(3),
+								}),
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(0),
+									protocol.NewSimpleInteger(1),
+								}),
+							}),
+						}),
+						protocol.NewBulkString("len"),
+						protocol.NewSimpleInteger(6),
+					}),
+				),
+			},
+		},
+		"lcs with idx filters short matches and includes match lengths": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("ohmytext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("mynewtext"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("key2" + uniqueSuffix),
+						protocol.NewBulkString("IDX"),
+						protocol.NewBulkString("MINMATCHLEN"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("WITHMATCHLEN"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("matches"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(4),
+									protocol.NewSimpleInteger(7),
+								}),
+								protocol.NewArray([]protocol.Data{
+									protocol.NewSimpleInteger(5),
+									protocol.NewSimpleInteger(8),
+								}),
+								protocol.NewSimpleInteger(4),
+							}),
+						}),
+						protocol.NewBulkString("len"),
+						protocol.NewSimpleInteger(6),
+					}),
+				),
+			},
+		},
+		"lcs of missing keys is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key-missing1" + uniqueSuffix),
+						protocol.NewBulkString("key-missing2" + uniqueSuffix),
+					},
+					protocol.NewBulkString(""),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key-missing1" + uniqueSuffix),
+						protocol.NewBulkString("key-missing2" + uniqueSuffix),
+						protocol.NewBulkString("IDX"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("matches"),
+						protocol.NewArray(nil),
+						protocol.NewBulkString("len"),
+						protocol.NewSimpleInteger(0),
+					}),
+				),
+			},
+		},
+		"lcs of a key holding a list must be strings": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("text"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1" + uniqueSuffix),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("ERR The specified keys must contain string values"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLIndexCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"lindex returns the value at the index from either end": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPUSH"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewBulkString("a"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewBulkString("c"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("-3"),
+					},
+					protocol.NewBulkString("b"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-index" + uniqueSuffix),
+						protocol.NewBulkString("4"),
+					},
+					nil,
+				),
+			},
+		},
+		"lindex of a missing key is nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					nil,
+				),
+			},
+		},
+		"lindex of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLInsertCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"linsert inserts before or after the pivot": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-insert" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-insert" + uniqueSuffix),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-insert" + uniqueSuffix),
+						protocol.NewBulkString("AFTER"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-insert" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+					}),
+				),
+			},
+		},
+		"linsert with a missing pivot does not insert": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-insert-pivot" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-insert-pivot" + uniqueSuffix),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("z"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(-1),
+				),
+			},
+		},
+		"linsert of a missing key does not create it": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"linsert of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
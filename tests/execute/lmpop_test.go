@@ -0,0 +1,190 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLMPopCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"lmpop pops from the first key holding a list": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					}),
+				),
+			},
+		},
+		"lmpop from the right with a count pops values from the end": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("c"),
+							protocol.NewBulkString("b"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+			},
+		},
+		"lmpop with a count beyond the length pops every value and removes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"lmpop of missing keys is a null array": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewNullArray(),
+				),
+			},
+		},
+		"lmpop of a key holding a string before a list is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLPosCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"lpos returns the index of the first match": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-position" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-position" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-position" + uniqueSuffix),
+						protocol.NewBulkString("z"),
+					},
+					nil,
+				),
+			},
+		},
+		"lpos with rank and count returns the indexes of later matches": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-position-rank" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-position-rank" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("RANK"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleInteger(2),
+						protocol.NewSimpleInteger(4),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-position-rank" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("RANK"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleInteger(4),
+						protocol.NewSimpleInteger(2),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-position-rank" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MAXLEN"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleInteger(0),
+					}),
+				),
+			},
+		},
+		"lpos of a missing key is nil or empty with count": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"lpos of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
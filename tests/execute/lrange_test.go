@@ -6,6 +6,7 @@ import (
 	"redis-challenge/tests"
 	"redis-challenge/tests/call"
 	"testing"
+	"time"
 )
 
 func TestLeftRange(t *testing.T) {
@@ -119,6 +120,36 @@ func TestLeftRange(t *testing.T) {
 				),
 			},
 		},
+		"lrange of a list that has expired is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIRE"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LRANGE"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray(nil),
+				).WithDelay(20 * time.Millisecond),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
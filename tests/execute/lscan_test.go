@@ -0,0 +1,230 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLScanCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"lscan of a small list returns every value in order": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+							protocol.NewBulkString("c"),
+						}),
+					}),
+				),
+			},
+		},
+		"lscan walks a list a page at a time with the index of the next value as the cursor": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("2"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("4"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("c"),
+							protocol.NewBulkString("d"),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("f"),
+					},
+					protocol.NewSimpleInteger(6),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("e"),
+							protocol.NewBulkString("f"),
+						}),
+					}),
+				),
+			},
+		},
+		"lscan leaves out values not matching the pattern": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("apple"),
+						protocol.NewBulkString("banana"),
+						protocol.NewBulkString("avocado"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("a*"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("apple"),
+							protocol.NewBulkString("avocado"),
+						}),
+					}),
+				),
+			},
+		},
+		"lscan from beyond the end of the list finishes at once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("9223372036854775807"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+						}),
+					}),
+				),
+			},
+		},
+		"lscan of a missing key finishes at once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				),
+			},
+		},
+		"lscan of a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
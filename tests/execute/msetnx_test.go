@@ -0,0 +1,113 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestMSetNxCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"msetnx sets every key when none exist": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MGET"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("two"),
+					}),
+				),
+			},
+		},
+		"msetnx sets no keys when any exist": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-existing" + uniqueSuffix),
+						protocol.NewBulkString("old"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+						protocol.NewBulkString("key-existing" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MGET"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("key-existing" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						nil,
+						protocol.NewBulkString("old"),
+					}),
+				),
+			},
+		},
+		"msetnx sets no keys when a key holds another type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+						protocol.NewBulkString("key-set" + uniqueSuffix),
+						protocol.NewBulkString("new"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package command_test
+
+import (
+	"fmt"
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"strings"
+	"testing"
+)
+
+func TestPublishSubscribe(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	bulk := func(text string) string {
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(text), text)
+	}
+	request := func(arguments ...string) string {
+		var builder strings.Builder
+		builder.WriteString(fmt.Sprintf("*%d\r\n", len(arguments)))
+		for _, argument := range arguments {
+			builder.WriteString(bulk(argument))
+		}
+		return builder.String()
+	}
+	push := func(symbol string, parts ...string) string {
+		return fmt.Sprintf("%s%d\r\n%s", symbol, len(parts), strings.Join(parts, ""))
+	}
+	count := func(n int) string {
+		return fmt.Sprintf(":%d\r\n", n)
+	}
+	on := func(connection int, c call.Call) tests.ConnectionCall {
+		return tests.ConnectionCall{Connection: connection, Call: c}
+	}
+	receive := func(connection int, expected string) tests.ConnectionCall {
+		return on(connection, call.NewFromProtocol("", expected))
+	}
+
+	hello3 := call.NewFromProtocolWithPartialResponse(request("HELLO", "3"), "%7\r\n")
+
+	news := "news" + uniqueSuffix
+	sport := "sport" + uniqueSuffix
+	newsPattern := "news*" + uniqueSuffix
+	shard := "{shard" + uniqueSuffix + "}.news"
+	otherShard := "{shard" + uniqueSuffix + "}.sport"
+	list := "list" + uniqueSuffix
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"a message published to a channel is received by its subscriber": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news), push("*", bulk("subscribe"), bulk(news), count(1)))),
+				on(1, call.NewFromProtocol(request("PUBLISH", news, "hello"), count(1))),
+				receive(0, push("*", bulk("message"), bulk(news), bulk("hello"))),
+			},
+		},
+		"a message published to a channel without subscribers is received by none": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news), push("*", bulk("subscribe"), bulk(news), count(1)))),
+				on(1, call.NewFromProtocol(request("PUBLISH", sport, "goal"), count(0))),
+				on(1, call.NewFromProtocol(request("PUBLISH", news, "hello"), count(1))),
+				receive(0, push("*", bulk("message"), bulk(news), bulk("hello"))),
+			},
+		},
+		"subscribing to several channels confirms each with the count of subscriptions": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news, sport, news),
+					push("*", bulk("subscribe"), bulk(news), count(1))+
+						push("*", bulk("subscribe"), bulk(sport), count(2))+
+						push("*", bulk("subscribe"), bulk(news), count(2)))),
+				on(0, call.NewFromProtocol(request("PSUBSCRIBE", newsPattern), push("*", bulk("psubscribe"), bulk(newsPattern), count(3)))),
+			},
+		},
+		"a message published to a channel matching a pattern is received by its subscriber": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("PSUBSCRIBE", newsPattern), push("*", bulk("psubscribe"), bulk(newsPattern), count(1)))),
+				on(1, call.NewFromProtocol(request("PUBLISH", "news.tech"+uniqueSuffix, "launch"), count(1))),
+				receive(0, push("*", bulk("pmessage"), bulk(newsPattern), bulk("news.tech"+uniqueSuffix), bulk("launch"))),
+			},
+		},
+		"unsubscribing from every channel confirms each channel": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news, sport),
+					push("*", bulk("subscribe"), bulk(news), count(1))+
+						push("*", bulk("subscribe"), bulk(sport), count(2)))),
+				on(0, call.NewFromProtocol(request("UNSUBSCRIBE"),
+					push("*", bulk("unsubscribe"), bulk(news), count(1))+
+						push("*", bulk("unsubscribe"), bulk(sport), count(0)))),
+				on(1, call.NewFromProtocol(request("PUBLISH", news, "hello"), count(0))),
+				on(0, call.NewFromProtocol(request("GET", news), "$-1\r\n")),
+			},
+		},
+		"unsubscribing without subscriptions confirms with no channel": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("UNSUBSCRIBE"), push("*", bulk("unsubscribe"), "$-1\r\n", count(0)))),
+				on(0, call.NewFromProtocol(request("PUNSUBSCRIBE"), push("*", bulk("punsubscribe"), "$-1\r\n", count(0)))),
+			},
+		},
+		"a subscribed connection using resp2 can only manage subscriptions and ping": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news), push("*", bulk("subscribe"), bulk(news), count(1)))),
+				on(0, call.NewFromProtocol(request("GET", news),
+					"-ERR Can't execute 'get': only (P|S)SUBSCRIBE / (P|S)UNSUBSCRIBE / PING / QUIT / RESET are allowed in this context\r\n")),
+				on(0, call.NewFromProtocol(request("PING"), push("*", bulk("pong"), bulk("")))),
+				on(0, call.NewFromProtocol(request("PING", "hi"), push("*", bulk("pong"), bulk("hi")))),
+				on(0, call.NewFromProtocol(request("PUNSUBSCRIBE", newsPattern), push("*", bulk("punsubscribe"), bulk(newsPattern), count(1)))),
+			},
+		},
+		"a subscribed connection using resp3 receives pushes and can run any command": {
+			calls: []tests.ConnectionCall{
+				on(0, hello3),
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news), push(">", bulk("subscribe"), bulk(news), count(1)))),
+				on(1, call.NewFromProtocol(request("PUBLISH", news, "hello"), count(1))),
+				receive(0, push(">", bulk("message"), bulk(news), bulk("hello"))),
+				on(0, call.NewFromProtocol(request("GET", news), "_\r\n")),
+				on(0, call.NewFromProtocol(request("PING"), "+PONG\r\n")),
+				on(0, call.NewFromProtocol(request("UNSUBSCRIBE"), push(">", bulk("unsubscribe"), bulk(news), count(0)))),
+			},
+		},
+		"every subscriber of a channel and matching pattern receives a message": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news), push("*", bulk("subscribe"), bulk(news), count(1)))),
+				on(1, call.NewFromProtocol(request("PSUBSCRIBE", "news*"), push("*", bulk("psubscribe"), bulk("news*"), count(1)))),
+				on(2, call.NewFromProtocol(request("PUBLISH", news, "hello"), count(2))),
+				receive(0, push("*", bulk("message"), bulk(news), bulk("hello"))),
+				receive(1, push("*", bulk("pmessage"), bulk("news*"), bulk(news), bulk("hello"))),
+			},
+		},
+		"pubsub reports the channels and their subscribers": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SUBSCRIBE", news, sport),
+					push("*", bulk("subscribe"), bulk(news), count(1))+
+						push("*", bulk("subscribe"), bulk(sport), count(2)))),
+				on(1, call.NewFromProtocol(request("SUBSCRIBE", news), push("*", bulk("subscribe"), bulk(news), count(1)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "CHANNELS", "*"+uniqueSuffix), push("*", bulk(news), bulk(sport)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "CHANNELS", "n*"+uniqueSuffix), push("*", bulk(news)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "NUMSUB", news, sport, "missing"+uniqueSuffix),
+					push("*", bulk(news), count(2), bulk(sport), count(1), bulk("missing"+uniqueSuffix), count(0)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "NUMSUB"), "*0\r\n")),
+			},
+		},
+		"pubsub numpat counts the patterns subscribed to": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("PSUBSCRIBE", newsPattern, "sport*"+uniqueSuffix),
+					push("*", bulk("psubscribe"), bulk(newsPattern), count(1))+
+						push("*", bulk("psubscribe"), bulk("sport*"+uniqueSuffix), count(2)))),
+				on(1, call.NewFromProtocol(request("PSUBSCRIBE", newsPattern), push("*", bulk("psubscribe"), bulk(newsPattern), count(1)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "NUMPAT"), count(2))),
+			},
+		},
+		"a message published to a shard channel is received by its subscriber": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", shard), push("*", bulk("ssubscribe"), bulk(shard), count(1)))),
+				on(1, call.NewFromProtocol(request("SPUBLISH", shard, "hello"), count(1))),
+				receive(0, push("*", bulk("smessage"), bulk(shard), bulk("hello"))),
+			},
+		},
+		"shard channels are apart from channels and patterns": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", shard), push("*", bulk("ssubscribe"), bulk(shard), count(1)))),
+				on(1, call.NewFromProtocol(request("SUBSCRIBE", shard), push("*", bulk("subscribe"), bulk(shard), count(1)))),
+				on(1, call.NewFromProtocol(request("PSUBSCRIBE", "*"), push("*", bulk("psubscribe"), bulk("*"), count(2)))),
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", otherShard), push("*", bulk("ssubscribe"), bulk(otherShard), count(2)))),
+				on(2, call.NewFromProtocol(request("SPUBLISH", shard, "sharded"), count(1))),
+				receive(0, push("*", bulk("smessage"), bulk(shard), bulk("sharded"))),
+				on(2, call.NewFromProtocol(request("PUBLISH", otherShard, "unsharded"), count(1))),
+				receive(1, push("*", bulk("pmessage"), bulk("*"), bulk(otherShard), bulk("unsharded"))),
+			},
+		},
+		"a connection subscribed to shard channels using resp2 can only manage subscriptions and ping": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", shard, otherShard),
+					push("*", bulk("ssubscribe"), bulk(shard), count(1))+
+						push("*", bulk("ssubscribe"), bulk(otherShard), count(2)))),
+				on(0, call.NewFromProtocol(request("GET", shard),
+					"-ERR Can't execute 'get': only (P|S)SUBSCRIBE / (P|S)UNSUBSCRIBE / PING / QUIT / RESET are allowed in this context\r\n")),
+				on(0, call.NewFromProtocol(request("UNSUBSCRIBE"), push("*", bulk("unsubscribe"), "$-1\r\n", count(0)))),
+				on(0, call.NewFromProtocol(request("SUNSUBSCRIBE"),
+					push("*", bulk("sunsubscribe"), bulk(shard), count(1))+
+						push("*", bulk("sunsubscribe"), bulk(otherShard), count(0)))),
+				on(0, call.NewFromProtocol(request("GET", shard), "$-1\r\n")),
+			},
+		},
+		"subscribing to shard channels in different slots is a cross slot error": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", "foo", "bar"), "-CROSSSLOT Keys in request don't hash to the same slot\r\n")),
+			},
+		},
+		"pubsub reports the shard channels and their subscribers": {
+			calls: []tests.ConnectionCall{
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", shard, otherShard),
+					push("*", bulk("ssubscribe"), bulk(shard), count(1))+
+						push("*", bulk("ssubscribe"), bulk(otherShard), count(2)))),
+				on(1, call.NewFromProtocol(request("SSUBSCRIBE", shard), push("*", bulk("ssubscribe"), bulk(shard), count(1)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "SHARDCHANNELS", "{shard"+uniqueSuffix+"}*"), push("*", bulk(shard), bulk(otherShard)))),
+				on(2, call.NewFromProtocol(request("PUBSUB", "CHANNELS", "{shard"+uniqueSuffix+"}*"), "*0\r\n")),
+				on(2, call.NewFromProtocol(request("PUBSUB", "SHARDNUMSUB", shard, otherShard),
+					push("*", bulk(shard), count(2), bulk(otherShard), count(1)))),
+			},
+		},
+		"a message is pushed while a blocking command of the subscriber waits": {
+			calls: []tests.ConnectionCall{
+				on(0, hello3),
+				on(0, call.NewFromProtocol(request("SSUBSCRIBE", shard), push(">", bulk("ssubscribe"), bulk(shard), count(1)))),
+				on(0, call.NewFromProtocolWithoutResponse(request("BLPOP", list, "0"))),
+				on(1, call.NewFromProtocol(request("SPUBLISH", shard, "hello"), count(1))),
+				receive(0, push(">", bulk("smessage"), bulk(shard), bulk("hello"))),
+				on(1, call.NewFromProtocol(request("RPUSH", list, "a"), count(1))),
+				receive(0, push("*", bulk(list), bulk("a"))),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
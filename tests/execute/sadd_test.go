@@ -0,0 +1,108 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSAddCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"sadd to a missing key creates the set and returns the count of added members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+			},
+		},
+		"sadd of existing members only counts added members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCARD"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+			},
+		},
+		"sadd with the same member twice adds it once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-twice" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+			},
+		},
+		"sadd to a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
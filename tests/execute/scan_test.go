@@ -0,0 +1,173 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+	"time"
+)
+
+func TestScanCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"scan of a small keyspace returns every key in one page": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("key-string" + uniqueSuffix),
+						}),
+					}),
+				),
+			},
+		},
+		"scan of an empty keyspace finishes at once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				),
+			},
+		},
+		"scan leaves out keys not matching the pattern": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-hello" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-world" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("key-h*"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("key-hello" + uniqueSuffix),
+						}),
+					}),
+				),
+			},
+		},
+		"scan leaves out keys of other types": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("key-list" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("TYPE"),
+						protocol.NewBulkString("list"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("key-list" + uniqueSuffix),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("type"),
+						protocol.NewBulkString("STRING"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("key-string" + uniqueSuffix),
+						}),
+					}),
+				),
+			},
+		},
+		"scan leaves out expired keys": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-expiring" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				).WithDelay(100 * time.Millisecond),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSelectCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"select keeps the keys of each database apart": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("zero"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						nil,
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("one"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("one"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("0"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("zero"),
+					),
+				},
+			},
+		},
+		"select only changes the database of its connection": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("one"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						nil,
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("one"),
+					),
+				},
+			},
+		},
+		"select of a database out of range is not allowed": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("16"),
+						},
+						protocol.NewSimpleError("ERR DB index is out of range"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("-1"),
+						},
+						protocol.NewSimpleError("ERR DB index is out of range"),
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSInterCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"sinter returns the members in every set": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-c" + uniqueSuffix),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m5"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+						protocol.NewBulkString("key-c" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTER"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+						protocol.NewBulkString("key-c" + uniqueSuffix),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+					},
+				),
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTER"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("key-c" + uniqueSuffix),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+					},
+				),
+			},
+		},
+		"sinter of a single member in common returns the member": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-one-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-one-b" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTER"),
+						protocol.NewBulkString("key-one-a" + uniqueSuffix),
+						protocol.NewBulkString("key-one-b" + uniqueSuffix),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("m2"),
+					}),
+				),
+			},
+		},
+		"sinter with a missing key is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-missing-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTER"),
+						protocol.NewBulkString("key-missing-a" + uniqueSuffix),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"sinter with a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-wrong-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTER"),
+						protocol.NewBulkString("key-wrong-a" + uniqueSuffix),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSInterCardCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"sintercard returns the size of the intersection": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("m4"),
+						protocol.NewBulkString("m5"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-a" + uniqueSuffix),
+						protocol.NewBulkString("key-b" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+			},
+		},
+		"sintercard stops counting at the limit": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-limit-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-limit-b" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-limit-a" + uniqueSuffix),
+						protocol.NewBulkString("key-limit-b" + uniqueSuffix),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-limit-a" + uniqueSuffix),
+						protocol.NewBulkString("key-limit-b" + uniqueSuffix),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+			},
+		},
+		"sintercard with a missing key is zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SADD"),
+						protocol.NewBulkString("key-missing-a" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-missing-a" + uniqueSuffix),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"sintercard with a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package command_test
+
+import (
+	"fmt"
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSortedSetScoresWithProtocolVersion3(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	hello3 := call.NewFromProtocolWithPartialResponse("*2\r\n$5\r\nHELLO\r\n$1\r\n3\r\n", "%7\r\n")
+	zadd := func(key string) call.Call {
+		return call.NewFromProtocol(fmt.Sprintf("*4\r\n$4\r\nZADD\r\n$%d\r\n%s\r\n$3\r\n2.5\r\n$2\r\nm1\r\n", len(key), key), ":1\r\n")
+	}
+
+	scoreKey := "key-score" + uniqueSuffix
+	incrementKey := "key-increment" + uniqueSuffix
+	rankKey := "key-rank" + uniqueSuffix
+	missingKey := "key-missing" + uniqueSuffix
+	rangeKey := "key-range" + uniqueSuffix
+	popKey := "key-pop" + uniqueSuffix
+	multiplePopKey := "key-multiple-pop" + uniqueSuffix
+
+	testCases := map[string]struct {
+		calls        []call.Call
+		driverChoice tests.ServerVariant
+	}{
+		"zscore replies with a double": {
+			calls: []call.Call{
+				hello3,
+				zadd(scoreKey),
+				call.NewFromProtocol(fmt.Sprintf("*3\r\n$6\r\nZSCORE\r\n$%d\r\n%s\r\n$2\r\nm1\r\n", len(scoreKey), scoreKey), ",2.5\r\n"),
+			},
+		},
+		"zincrby replies with a double": {
+			calls: []call.Call{
+				hello3,
+				call.NewFromProtocol(fmt.Sprintf("*4\r\n$7\r\nZINCRBY\r\n$%d\r\n%s\r\n$3\r\ninf\r\n$2\r\nm1\r\n", len(incrementKey), incrementKey), ",inf\r\n"),
+			},
+		},
+		"zrank with score replies with the rank and a double": {
+			calls: []call.Call{
+				hello3,
+				zadd(rankKey),
+				call.NewFromProtocol(fmt.Sprintf("*4\r\n$5\r\nZRANK\r\n$%d\r\n%s\r\n$2\r\nm1\r\n$9\r\nWITHSCORE\r\n", len(rankKey), rankKey), "*2\r\n:0\r\n,2.5\r\n"),
+			},
+		},
+		"zrank with score of a missing member replies with null": {
+			calls: []call.Call{
+				hello3,
+				call.NewFromProtocol(fmt.Sprintf("*4\r\n$5\r\nZRANK\r\n$%d\r\n%s\r\n$2\r\nm1\r\n$9\r\nWITHSCORE\r\n", len(missingKey), missingKey), "_\r\n"),
+			},
+		},
+		"zrange with scores replies with member and double pairs": {
+			calls: []call.Call{
+				hello3,
+				zadd(rangeKey),
+				call.NewFromProtocol(fmt.Sprintf("*5\r\n$6\r\nZRANGE\r\n$%d\r\n%s\r\n$1\r\n0\r\n$2\r\n-1\r\n$10\r\nWITHSCORES\r\n", len(rangeKey), rangeKey), "*1\r\n*2\r\n$2\r\nm1\r\n,2.5\r\n"),
+			},
+		},
+		"zpopmin with count replies with member and double pairs": {
+			calls: []call.Call{
+				hello3,
+				zadd(popKey),
+				call.NewFromProtocol(fmt.Sprintf("*3\r\n$7\r\nZPOPMIN\r\n$%d\r\n%s\r\n$1\r\n5\r\n", len(popKey), popKey), "*1\r\n*2\r\n$2\r\nm1\r\n,2.5\r\n"),
+			},
+		},
+		"zmpop replies with the key and member and double pairs": {
+			calls: []call.Call{
+				hello3,
+				zadd(multiplePopKey),
+				call.NewFromProtocol(fmt.Sprintf("*4\r\n$5\r\nZMPOP\r\n$1\r\n1\r\n$%d\r\n%s\r\n$3\r\nMAX\r\n", len(multiplePopKey), multiplePopKey), fmt.Sprintf("*2\r\n$%d\r\n%s\r\n*1\r\n*2\r\n$2\r\nm1\r\n,2.5\r\n", len(multiplePopKey), multiplePopKey)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
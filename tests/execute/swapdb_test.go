@@ -0,0 +1,238 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSwapDBCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"swapdb swaps the keys of two databases": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("zero"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						nil,
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("zero"),
+					),
+				},
+			},
+		},
+		"swapdb is seen by connections with either database selected": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("zero"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("1"),
+							protocol.NewBulkString("0"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("zero"),
+					),
+				},
+			},
+		},
+		"swapdb keeps the expiry of keys": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("zero"),
+							protocol.NewBulkString("EX"),
+							protocol.NewBulkString("100"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SELECT"),
+							protocol.NewBulkString("1"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("TTL"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(100),
+					),
+				},
+			},
+		},
+		"swapdb of a database with itself changes nothing": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+							protocol.NewBulkString("zero"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("0"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key" + uniqueSuffix),
+						},
+						protocol.NewBulkString("zero"),
+					),
+				},
+			},
+		},
+		"swapdb of a database out of range is not allowed": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("0"),
+							protocol.NewBulkString("16"),
+						},
+						protocol.NewSimpleError("ERR DB index is out of range"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SWAPDB"),
+							protocol.NewBulkString("-1"),
+							protocol.NewBulkString("0"),
+						},
+						protocol.NewSimpleError("ERR DB index is out of range"),
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,572 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestTransaction(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"queued commands execute together on exec replying with each of their replies": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("counter-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleString("OK"),
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("value"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-in-transaction" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+			},
+		},
+		"a transaction without commands replies with an empty array": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"discarding a transaction executes none of its commands": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-discarded" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DISCARD"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-discarded" + uniqueSuffix),
+					},
+					nil,
+				),
+			},
+		},
+		"exec and discard without multi are errors": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewSimpleError("ERR EXEC without MULTI"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DISCARD"),
+					},
+					protocol.NewSimpleError("ERR DISCARD without MULTI"),
+				),
+			},
+		},
+		"multi within a transaction is an error that keeps the transaction": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-nested" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleError("ERR MULTI calls can not be nested"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleString("OK"),
+					}),
+				),
+			},
+		},
+		"a command failing validation discards the transaction on exec": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-aborted" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-aborted" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'set' command"),
+				),
+				call.NewFromDataWithPartialError(
+					[]protocol.Data{
+						protocol.NewBulkString("NOSUCHCOMMAND"),
+					},
+					"ERR unknown command 'NOSUCHCOMMAND'",
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewSimpleError("EXECABORT Transaction discarded because of previous errors."),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-aborted" + uniqueSuffix),
+					},
+					nil,
+				),
+			},
+		},
+		"a command failing while executing does not stop the commands after it": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-wrong-type" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPUSH"),
+						protocol.NewBulkString("key-wrong-type" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key-wrong-type" + uniqueSuffix),
+						protocol.NewBulkString("s"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromDataWithPartialError(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					"WRONGTYPE Operation against a key holding the wrong kind of value",
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-wrong-type" + uniqueSuffix),
+					},
+					protocol.NewBulkString("values"),
+				),
+			},
+		},
+		"selecting a database in a transaction applies to the commands after it": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-selected" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleString("OK"),
+						protocol.NewSimpleString("OK"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-selected" + uniqueSuffix),
+					},
+					protocol.NewBulkString("value"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SELECT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("key-selected" + uniqueSuffix),
+					},
+					nil,
+				),
+			},
+		},
+		"a blocking command in a transaction replies as if it timed out instead of waiting": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MULTI"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("list-empty" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPUSH"),
+						protocol.NewBulkString("list-empty" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("list-empty" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleString("QUEUED"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXEC"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewNullArray(),
+						protocol.NewSimpleInteger(1),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("list-empty" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+						}),
+					}),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
+
+func TestTransactionAcrossConnections(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []tests.ConnectionCall
+		driverChoice tests.ServerVariant
+	}{
+		"commands queued by one connection are not seen by another until exec": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("MULTI"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SET"),
+							protocol.NewBulkString("key-across" + uniqueSuffix),
+							protocol.NewBulkString("value"),
+						},
+						protocol.NewSimpleString("QUEUED"),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key-across" + uniqueSuffix),
+						},
+						nil,
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("EXEC"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewSimpleString("OK"),
+						}),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("GET"),
+							protocol.NewBulkString("key-across" + uniqueSuffix),
+						},
+						protocol.NewBulkString("value"),
+					),
+				},
+			},
+		},
+		"a push in a transaction serves a command blocked on another connection": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 1,
+					Call: call.NewFromDataWithoutResponse(
+						[]protocol.Data{
+							protocol.NewBulkString("BLPOP"),
+							protocol.NewBulkString("list-across" + uniqueSuffix),
+							protocol.NewBulkString("0"),
+						},
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("MULTI"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("RPUSH"),
+							protocol.NewBulkString("list-across" + uniqueSuffix),
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("b"),
+						},
+						protocol.NewSimpleString("QUEUED"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LLEN"),
+							protocol.NewBulkString("list-across" + uniqueSuffix),
+						},
+						protocol.NewSimpleString("QUEUED"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("EXEC"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewSimpleInteger(2),
+							protocol.NewSimpleInteger(2),
+						}),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("list-across" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					})),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("LLEN"),
+							protocol.NewBulkString("list-across" + uniqueSuffix),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+			},
+		},
+		"subscribing to several channels in a transaction replies with an element for each": {
+			calls: []tests.ConnectionCall{
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("MULTI"),
+						},
+						protocol.NewSimpleString("OK"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("SUBSCRIBE"),
+							protocol.NewBulkString("news" + uniqueSuffix),
+							protocol.NewBulkString("sport" + uniqueSuffix),
+						},
+						protocol.NewSimpleString("QUEUED"),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("EXEC"),
+						},
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("subscribe"),
+								protocol.NewBulkString("news" + uniqueSuffix),
+								protocol.NewSimpleInteger(1),
+							}),
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("subscribe"),
+								protocol.NewBulkString("sport" + uniqueSuffix),
+								protocol.NewSimpleInteger(2),
+							}),
+						}),
+					),
+				},
+				{
+					Connection: 1,
+					Call: call.NewFromData(
+						[]protocol.Data{
+							protocol.NewBulkString("PUBLISH"),
+							protocol.NewBulkString("sport" + uniqueSuffix),
+							protocol.NewBulkString("goal"),
+						},
+						protocol.NewSimpleInteger(1),
+					),
+				},
+				{
+					Connection: 0,
+					Call: call.NewResponseFromData(protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("message"),
+						protocol.NewBulkString("sport" + uniqueSuffix),
+						protocol.NewBulkString("goal"),
+					})),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveConnectionsAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
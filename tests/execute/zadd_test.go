@@ -0,0 +1,402 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZAddCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zadd to a missing key creates the sorted set and returns the count of added members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCARD"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-new" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewBulkString("2"),
+				),
+			},
+		},
+		"zadd of existing members updates scores and only counts added members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-update" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("5"),
+				),
+			},
+		},
+		"zadd with CH counts added and changed members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-ch" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-ch" + uniqueSuffix),
+						protocol.NewBulkString("CH"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+			},
+		},
+		"zadd with NX only adds missing members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-nx" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-nx" + uniqueSuffix),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-nx" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("1"),
+				),
+			},
+		},
+		"zadd with XX only updates existing members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-xx" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-xx" + uniqueSuffix),
+						protocol.NewBulkString("XX"),
+						protocol.NewBulkString("CH"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-xx" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+					},
+					nil,
+				),
+			},
+		},
+		"zadd with XX to a missing key does not create it": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-xx-missing" + uniqueSuffix),
+						protocol.NewBulkString("XX"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-xx-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zadd with GT only increases scores": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-gt" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-gt" + uniqueSuffix),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("CH"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("7"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-gt" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("5"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-gt" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewBulkString("7"),
+				),
+			},
+		},
+		"zadd with LT only decreases scores": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-lt" + uniqueSuffix),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-lt" + uniqueSuffix),
+						protocol.NewBulkString("LT"),
+						protocol.NewBulkString("7"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-lt" + uniqueSuffix),
+						protocol.NewBulkString("LT"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-lt" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("3"),
+				),
+			},
+		},
+		"zadd with INCR returns the new score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr" + uniqueSuffix),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr" + uniqueSuffix),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("3.5"),
+				),
+			},
+		},
+		"zadd with INCR that is not allowed returns nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr-nx" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr-nx" + uniqueSuffix),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m1"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr-nx" + uniqueSuffix),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("m1"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-incr-nx" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("1"),
+				),
+			},
+		},
+		"zadd with INCR that would produce NaN is not a number": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr-nan" + uniqueSuffix),
+						protocol.NewBulkString("inf"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr-nan" + uniqueSuffix),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("ERR resulting score is not a number (NaN)"),
+				),
+			},
+		},
+		"zadd to a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZCountCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zcount returns the number of members in the score range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("(2"),
+						protocol.NewBulkString("(4"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("+inf"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zcount of a missing key is zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("+inf"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zcount of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZDiffStoreCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zdiffstore keeps the entries of the first sorted set not in the others": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-diff-1" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-diff-2" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("key-diff" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key-diff-1" + uniqueSuffix),
+						protocol.NewBulkString("key-diff-2" + uniqueSuffix),
+						protocol.NewBulkString("key-diff-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-diff" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("3"),
+					}),
+				),
+			},
+		},
+		"zdiffstore of a missing first key removes the destination": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-diff-empty" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("key-diff-empty" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-diff-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-diff-empty" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zdiffstore of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("key-diff-wrong" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-diff-missing" + uniqueSuffix),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZIncrByCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zincrby adds to the score of the member": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-incr" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-incr" + uniqueSuffix),
+						protocol.NewBulkString("2.5"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("3.5"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-incr" + uniqueSuffix),
+						protocol.NewBulkString("-0.5"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("3"),
+				),
+			},
+		},
+		"zincrby adds a missing member with the increment as its score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0.1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("0.1"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0.2"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("0.30000000000000004"),
+				),
+			},
+		},
+		"zincrby that would produce NaN is not a number": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-nan" + uniqueSuffix),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-nan" + uniqueSuffix),
+						protocol.NewBulkString("inf"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("ERR resulting score is not a number (NaN)"),
+				),
+			},
+		},
+		"zincrby of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
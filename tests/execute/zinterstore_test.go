@@ -0,0 +1,188 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZInterStoreCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zinterstore adds the scores of members in every sorted set": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-inter-1" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-inter-2" + uniqueSuffix),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("20"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("30"),
+						protocol.NewBulkString("d"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("key-inter" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-inter-1" + uniqueSuffix),
+						protocol.NewBulkString("key-inter-2" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-inter" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("12"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("23"),
+					}),
+				),
+			},
+		},
+		"zinterstore with weights keeps the minimum": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-minimum-1" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-minimum-2" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("key-minimum" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-minimum-1" + uniqueSuffix),
+						protocol.NewBulkString("key-minimum-2" + uniqueSuffix),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("AGGREGATE"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-minimum" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("2"),
+					}),
+				),
+			},
+		},
+		"zinterstore with a missing key removes the destination": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-inter-empty" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("key-inter-empty" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-inter-empty" + uniqueSuffix),
+						protocol.NewBulkString("key-inter-missing" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-inter-empty" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zinterstore of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("key-inter-wrong" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
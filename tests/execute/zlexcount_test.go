@@ -0,0 +1,108 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZLexCountCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zlexcount returns the number of members in the lex range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("[b"),
+						protocol.NewBulkString("[d"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("(b"),
+						protocol.NewBulkString("(d"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+			},
+		},
+		"zlexcount of a missing key is zero": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zlexcount of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
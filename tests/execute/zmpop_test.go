@@ -0,0 +1,147 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZMPopCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zmpop pops from the first key holding members": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-first" + uniqueSuffix),
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-second" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("a"),
+								protocol.NewBulkString("1"),
+							}),
+						}),
+					}),
+				),
+			},
+		},
+		"zmpop with count pops members from the highest score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("MAX"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("c"),
+								protocol.NewBulkString("3"),
+							}),
+							protocol.NewArray([]protocol.Data{
+								protocol.NewBulkString("b"),
+								protocol.NewBulkString("2"),
+							}),
+						}),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-count" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+			},
+		},
+		"zmpop of missing keys is a null array": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key-missing-1" + uniqueSuffix),
+						protocol.NewBulkString("key-missing-2" + uniqueSuffix),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewNullArray(),
+				),
+			},
+		},
+		"zmpop of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
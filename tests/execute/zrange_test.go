@@ -0,0 +1,389 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRangeCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zrange by rank returns members ordered by score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"zrange by rank reversed returns members from the highest score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-rank-rev" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-rank-rev" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("REV"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("e"),
+						protocol.NewBulkString("d"),
+					}),
+				),
+			},
+		},
+		"zrange with scores returns members and scores": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-scores" + uniqueSuffix),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-scores" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("2"),
+					}),
+				),
+			},
+		},
+		"zrange by score with exclusive and infinite bounds": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("(2"),
+						protocol.NewBulkString("+inf"),
+						protocol.NewBulkString("BYSCORE"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("(2"),
+						protocol.NewBulkString("BYSCORE"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("(3"),
+						protocol.NewBulkString("(3"),
+						protocol.NewBulkString("BYSCORE"),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"zrange by score with limit": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-score-limit" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score-limit" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score-limit" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					}),
+				),
+			},
+		},
+		"zrange by score reversed takes the maximum first": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-score-rev" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-score-rev" + uniqueSuffix),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("(1"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("REV"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("4"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("3"),
+					}),
+				),
+			},
+		},
+		"zrange by lex with inclusive, exclusive and infinite bounds": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-lex" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-lex" + uniqueSuffix),
+						protocol.NewBulkString("[b"),
+						protocol.NewBulkString("(d"),
+						protocol.NewBulkString("BYLEX"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("c"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-lex" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("[b"),
+						protocol.NewBulkString("BYLEX"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					}),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-lex" + uniqueSuffix),
+						protocol.NewBulkString("+"),
+						protocol.NewBulkString("(c"),
+						protocol.NewBulkString("BYLEX"),
+						protocol.NewBulkString("REV"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("d"),
+					}),
+				),
+			},
+		},
+		"zrange of a missing key is empty": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray(nil),
+				),
+			},
+		},
+		"zrange of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRankCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zrank returns the position ordered by score then member": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(4),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("d"),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("c"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-rank" + uniqueSuffix),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleInteger(3),
+				),
+			},
+		},
+		"zrank with score returns the position and score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2.5"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-score" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("WITHSCORE"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("2.5"),
+					}),
+				),
+			},
+		},
+		"zrank of a missing member is nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-member" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-member" + uniqueSuffix),
+						protocol.NewBulkString("missing"),
+					},
+					nil,
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-member" + uniqueSuffix),
+						protocol.NewBulkString("missing"),
+						protocol.NewBulkString("WITHSCORE"),
+					},
+					protocol.NewNullArray(),
+				),
+			},
+		},
+		"zrank of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANK"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRemRangeByLexCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zremrangebylex removes the members in the lex range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-remove" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key-remove" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("(c"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key-remove" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("e"),
+					}),
+				),
+			},
+		},
+		"zremrangebylex of every member removes the key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-remove-all" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("b"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("c"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("d"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("e"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key-remove-all" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXISTS"),
+						protocol.NewBulkString("key-remove-all" + uniqueSuffix),
+					},
+					protocol.NewSimpleInteger(0),
+				),
+			},
+		},
+		"zremrangebylex of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZScanCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zscan of a small sorted set returns every member with its score": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCAN"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("a"),
+							protocol.NewBulkString("1.5"),
+						}),
+					}),
+				),
+			},
+		},
+		"zscan leaves out members not matching the pattern": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("apple"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("banana"),
+					},
+					protocol.NewSimpleInteger(2),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCAN"),
+						protocol.NewBulkString("key-zset" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("b*"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray([]protocol.Data{
+							protocol.NewBulkString("banana"),
+							protocol.NewBulkString("2"),
+						}),
+					}),
+				),
+			},
+		},
+		"zscan of a missing key finishes at once": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCAN"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewArray([]protocol.Data{
+						protocol.NewBulkString("0"),
+						protocol.NewArray(nil),
+					}),
+				),
+			},
+		},
+		"zscan of a string is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCAN"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
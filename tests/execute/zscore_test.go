@@ -0,0 +1,140 @@
+package command_test
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZScoreCommand(t *testing.T) {
+
+	uniqueSuffix := "-" + nanoid.Must(6)
+
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.ServerVariant
+	}{
+		"zscore returns the score formatted like redis": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("0.1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("1e20"),
+						protocol.NewBulkString("m2"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("m3"),
+						protocol.NewBulkString("3.0"),
+						protocol.NewBulkString("m4"),
+						protocol.NewBulkString("1.5e-7"),
+						protocol.NewBulkString("m5"),
+					},
+					protocol.NewSimpleInteger(5),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewBulkString("0.1"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewBulkString("1e+20"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("m3"),
+					},
+					protocol.NewBulkString("-inf"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("m4"),
+					},
+					protocol.NewBulkString("3"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-format" + uniqueSuffix),
+						protocol.NewBulkString("m5"),
+					},
+					protocol.NewBulkString("1.5e-7"),
+				),
+			},
+		},
+		"zscore of a missing member is nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key-member" + uniqueSuffix),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleInteger(1),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-member" + uniqueSuffix),
+						protocol.NewBulkString("missing"),
+					},
+					nil,
+				),
+			},
+		},
+		"zscore of a missing key is nil": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-missing" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					nil,
+				),
+			},
+		},
+		"zscore of a key with a string value is the wrong type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleString("OK"),
+				),
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZSCORE"),
+						protocol.NewBulkString("key-string" + uniqueSuffix),
+						protocol.NewBulkString("m1"),
+					},
+					protocol.NewSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.DriveProtocolAgainstServer(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -37,6 +37,63 @@ func (s ServerVariant) Sleep(clock store.Clock, c call.Call) {
 }
 
 func DriveProtocolAgainstServer[T call.Call](t testing.TB, calls []T, variant ServerVariant, options ...any) {
+	clock, logWriter := driverOptions(options)
+
+	testServer := createTestServer(t, clock, variant, logWriter)
+	defer func() {
+		require.NoError(t, testServer.Close(), "failed to close test server")
+	}()
+
+	SendCallsToServer(t, testServer, calls, variant, clock)
+}
+
+// ConnectionCall is a call sent on one of several connections to the same server, where connections are numbered
+// from zero.
+type ConnectionCall struct {
+	Connection int
+	Call       call.Call
+}
+
+// requestWithoutResponseSettleTime is how long to wait after sending a request without reading its response, so
+// the server has received it before a later call relies on it, such as a push to a key that a blocking command
+// waits on or a delay that moves the clock past its timeout.
+const requestWithoutResponseSettleTime = 20 * time.Millisecond
+
+// responseReadTimeout limits how long a response is waited for, so a blocking command that is never served fails
+// the test rather than hanging it.
+const responseReadTimeout = 5 * time.Second
+
+// DriveConnectionsAgainstServer sends each call on its connection in order, opening a connection on its first call.
+func DriveConnectionsAgainstServer(t testing.TB, calls []ConnectionCall, variant ServerVariant, options ...any) {
+	clock, logWriter := driverOptions(options)
+
+	testServer := createTestServer(t, clock, variant, logWriter)
+	defer func() {
+		require.NoError(t, testServer.Close(), "failed to close test server")
+	}()
+
+	connections := make(map[int]net.Conn)
+	defer func() {
+		for _, connection := range connections {
+			require.NoError(t, connection.Close(), "failed to close connection to the test server")
+		}
+	}()
+
+	for _, next := range calls {
+		connection, ok := connections[next.Connection]
+		if !ok {
+			var err error
+			connection, err = net.DialTimeout("tcp", testServer.Address(), timeout)
+			require.NoError(t, err)
+			connections[next.Connection] = connection
+		}
+
+		require.NoError(t, connection.SetReadDeadline(time.Now().Add(responseReadTimeout)))
+		sendCall(t, connection, next.Call, variant, clock)
+	}
+}
+
+func driverOptions(options []any) (store.Clock, io.Writer) {
 	var clock store.Clock = &store.FixedClock{TimeInMilliseconds: time.Now().UnixMilli()}
 	logWriter := io.Discard
 
@@ -49,13 +106,7 @@ func DriveProtocolAgainstServer[T call.Call](t testing.TB, calls []T, variant Se
 			clock = definedClock
 		}
 	}
-
-	testServer := createTestServer(t, clock, variant, logWriter)
-	defer func() {
-		require.NoError(t, testServer.Close(), "failed to close test server")
-	}()
-
-	SendCallsToServer(t, testServer, calls, variant, clock)
+	return clock, logWriter
 }
 
 func SendCallsToServer[T call.Call](t testing.TB, testServer server.Server, calls []T, variant ServerVariant, clock store.Clock) {
@@ -67,29 +118,36 @@ func SendCallsToServer[T call.Call](t testing.TB, testServer server.Server, call
 	}()
 
 	for _, nextCall := range calls {
-		variant.Sleep(clock, nextCall)
+		sendCall(t, connection, nextCall, variant, clock)
+	}
+}
+
+func sendCall(t testing.TB, connection net.Conn, nextCall call.Call, variant ServerVariant, clock store.Clock) {
+	variant.Sleep(clock, nextCall)
 
-		request := nextCall.Request()
+	request := nextCall.Request()
+	if len(request) > 0 {
 		_, err := connection.Write([]byte(request))
 		require.NoError(t, err, "failed to write request: %s", request)
+	}
 
-		if !nextCall.IsResponseExpected() {
-			continue
-		}
-
-		response := ""
+	if !nextCall.IsResponseExpected() {
+		time.Sleep(requestWithoutResponseSettleTime)
+		return
+	}
 
-		buffer := make([]byte, LargeStringByteCount+20)
+	response := ""
 
-		for nextCall.IsPossiblePartialResponse(response) {
-			n, err := connection.Read(buffer)
-			require.NoError(t, err, "failed to read reply to the request: %s", request)
+	buffer := make([]byte, LargeStringByteCount+20)
 
-			response += string(buffer[:n])
-		}
+	for nextCall.IsPossiblePartialResponse(response) {
+		n, err := connection.Read(buffer)
+		require.NoError(t, err, "failed to read reply to the request: %s", request)
 
-		nextCall.ConfirmResponse(t, response)
+		response += string(buffer[:n])
 	}
+
+	nextCall.ConfirmResponse(t, response)
 }
 
 func createTestServer(t testing.TB, clock store.Clock, variant ServerVariant, logWriter io.Writer) server.Server {
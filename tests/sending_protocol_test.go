@@ -12,6 +12,15 @@ func TestSendingProtocolToServer(t *testing.T) {
 
 	const largeStringByteCount = tests.LargeStringByteCount
 
+	const pipelinedCommandCount = 500
+
+	var pipelinedRequests, pipelinedResponses strings.Builder
+	for i := range pipelinedCommandCount {
+		message := fmt.Sprintf("message %d", i)
+		pipelinedRequests.WriteString(fmt.Sprintf("*2\r\n$4\r\nECHO\r\n$%d\r\n%s\r\n", len(message), message))
+		pipelinedResponses.WriteString(fmt.Sprintf("$%d\r\n%s\r\n", len(message), message))
+	}
+
 	testCases := map[string]struct {
 		calls   []call.Call
 		variant tests.ServerVariant
@@ -63,6 +72,34 @@ func TestSendingProtocolToServer(t *testing.T) {
 				),
 			},
 		},
+		"send two pipelined echos in one write and receive replies to each in order": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"*2\r\n$4\r\nECHO\r\n$5\r\nfirst\r\n*2\r\n$4\r\nECHO\r\n$6\r\nsecond\r\n",
+					"$5\r\nfirst\r\n$6\r\nsecond\r\n",
+				),
+			},
+		},
+		"send hundreds of pipelined echos in one write and receive replies to each in order": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					pipelinedRequests.String(),
+					pipelinedResponses.String(),
+				),
+			},
+		},
+		"send pipelined echos where the second is split across 2 requests": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"*2\r\n$4\r\nECHO\r\n$5\r\nfirst\r\n*2\r\n$4\r\nECHO\r\n$6\r\nsec",
+					"$5\r\nfirst\r\n",
+				),
+				call.NewFromProtocol(
+					"ond\r\n",
+					"$6\r\nsecond\r\n",
+				),
+			},
+		},
 		"send echo with large message": {
 			calls: []call.Call{
 				call.NewFromProtocol(
@@ -95,6 +132,46 @@ func TestSendingProtocolToServer(t *testing.T) {
 				),
 			},
 		},
+		"send inline ping and receive PONG": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"PING\r\n",
+					"+PONG\r\n",
+				),
+			},
+		},
+		"send inline ping terminated by newline only and receive PONG": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"PING\n",
+					"+PONG\r\n",
+				),
+			},
+		},
+		"send inline echo with quoted message and receive message back in reply": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"ECHO \"hello world\"\r\n",
+					"$11\r\nhello world\r\n",
+				),
+			},
+		},
+		"send blank inline line is ignored": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"\r\nPING\r\n",
+					"+PONG\r\n",
+				),
+			},
+		},
+		"send inline command with unbalanced quotes should receive error message": {
+			calls: []call.Call{
+				call.NewFromProtocol(
+					"ECHO \"hello\r\n",
+					"-ERR Protocol error: unbalanced quotes in request\r\n",
+				),
+			},
+		},
 		"send bad command should receive error message": {
 			calls: []call.Call{
 				call.NewFromProtocolWithPartialResponse(
@@ -0,0 +1,91 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestAppendValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"append command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'append' command"),
+				),
+			},
+		},
+		"append command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"append command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"append command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'append' command"),
+				),
+			},
+		},
+		"append command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'append' command"),
+				),
+			},
+		},
+		"append command with integer value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("APPEND"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestBLMoveValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"blmove command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blmove' command"),
+				),
+			},
+		},
+		"blmove command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0.01"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"blmove command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"blmove command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blmove' command"),
+				),
+			},
+		},
+		"blmove command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0.01"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blmove' command"),
+				),
+			},
+		},
+		"blmove command with lowercase directions is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("right"),
+						protocol.NewBulkString("left"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"blmove command with simple string destination has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewSimpleString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"blmove command with unknown source direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("UP"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"blmove command with unknown destination direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("DOWN"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"blmove command with non-float timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("soon"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"blmove command with negative timeout is negative": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR timeout is negative"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestBLMPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"blmpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blmpop' command"),
+				),
+			},
+		},
+		"blmpop command without direction has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blmpop' command"),
+				),
+			},
+		},
+		"blmpop command with integer timeout has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"blmpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"blmpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0.01"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("right"),
+						protocol.NewBulkString("count"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"blmpop command with non-float timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("soon"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"blmpop command with negative timeout is negative": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR timeout is negative"),
+				),
+			},
+		},
+		"blmpop command with zero number of keys is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"blmpop command with more keys than given is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"blmpop command with unknown direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("UP"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"blmpop command with zero count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR count should be greater than 0"),
+				),
+			},
+		},
+		"blmpop command with count missing its value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"blmpop command with repeated count is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
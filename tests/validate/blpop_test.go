@@ -0,0 +1,138 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestBLPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"blpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blpop' command"),
+				),
+			},
+		},
+		"blpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0.01"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"blpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"blpop command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'blpop' command"),
+				),
+			},
+		},
+		"blpop command with several keys is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"blpop command with integer timeout has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"blpop command with non-float timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("soon"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"blpop command with nan timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("nan"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"blpop command with negative timeout is negative": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-0.5"),
+					},
+					protocol.NewSimpleError("ERR timeout is negative"),
+				),
+			},
+		},
+		"blpop command with huge timeout is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BLPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1e20"),
+					},
+					protocol.NewSimpleError("ERR timeout is out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestBRPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"brpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'brpop' command"),
+				),
+			},
+		},
+		"brpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0.01"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"brpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"brpop command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'brpop' command"),
+				),
+			},
+		},
+		"brpop command with several keys is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("0.01"),
+					},
+				),
+			},
+		},
+		"brpop command with integer timeout has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"brpop command with non-float timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("soon"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"brpop command with nan timeout is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("nan"),
+					},
+					protocol.NewSimpleError("ERR timeout is not a float or out of range"),
+				),
+			},
+		},
+		"brpop command with negative timeout is negative": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-0.5"),
+					},
+					protocol.NewSimpleError("ERR timeout is negative"),
+				),
+			},
+		},
+		"brpop command with huge timeout is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("BRPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1e20"),
+					},
+					protocol.NewSimpleError("ERR timeout is out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
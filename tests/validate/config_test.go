@@ -0,0 +1,114 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestConfigValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"config command with no arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+					},
+				),
+			},
+		},
+		"config command with simple string subcommand has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewSimpleString("GET"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"config get command without a parameter has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("GET"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'config|get' command"),
+				),
+			},
+		},
+		"config get command with a parameter is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("GET"),
+						protocol.NewBulkString("notify-keyspace-events"),
+					},
+				),
+			},
+		},
+		"config get command with several parameters is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("get"),
+						protocol.NewBulkString("notify-*"),
+						protocol.NewBulkString("maxmemory"),
+					},
+				),
+			},
+		},
+		"config set command without a value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("notify-keyspace-events"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'config|set' command"),
+				),
+			},
+		},
+		"config set command with a parameter and value is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("notify-keyspace-events"),
+						protocol.NewBulkString("KEA"),
+					},
+				),
+			},
+		},
+		"config set command with a parameter missing its value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("CONFIG"),
+						protocol.NewBulkString("SET"),
+						protocol.NewBulkString("notify-keyspace-events"),
+						protocol.NewBulkString("KEA"),
+						protocol.NewBulkString("notify-keyspace-events"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'config|set' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
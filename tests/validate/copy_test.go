@@ -0,0 +1,132 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestCopyValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"copy command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'copy' command"),
+				),
+			},
+		},
+		"copy command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("destination"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"copy command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+					},
+				),
+			},
+		},
+		"copy command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'copy' command"),
+				),
+			},
+		},
+		"copy command with every option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("db"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("replace"),
+					},
+				),
+			},
+		},
+		"copy command with missing database is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("DB"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"copy command with non-integer database is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("DB"),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"copy command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("KEEPTTL"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"copy command to the same key is not allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("COPY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR source and destination objects are the same"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
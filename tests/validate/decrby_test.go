@@ -0,0 +1,115 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestDecrByValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"decrby command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'decrby' command"),
+				),
+			},
+		},
+		"decrby command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"decrby command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("5"),
+					},
+				),
+			},
+		},
+		"decrby command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'decrby' command"),
+				),
+			},
+		},
+		"decrby command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'decrby' command"),
+				),
+			},
+		},
+		"decrby command with integer decrement has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(5),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"decrby command with non-integer decrement is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("five"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"decrby command with smallest integer decrement would overflow": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("DECRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-9223372036854775808"),
+					},
+					protocol.NewSimpleError("ERR decrement would overflow"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestExpireAtValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"expireat command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'expireat' command"),
+				),
+			},
+		},
+		"expireat command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("4102444800"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"expireat command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800"),
+					},
+				),
+			},
+		},
+		"expireat command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'expireat' command"),
+				),
+			},
+		},
+		"expireat command with every condition option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800"),
+						protocol.NewBulkString("xx"),
+						protocol.NewBulkString("gt"),
+					},
+				),
+			},
+		},
+		"expireat command with integer expiry has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(10),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"expireat command with non-integer expiry is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"expireat command with unknown option is unsupported": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800"),
+						protocol.NewBulkString("AT"),
+					},
+					protocol.NewSimpleError("ERR Unsupported option AT"),
+				),
+			},
+		},
+		"expireat command with nx and xx is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800"),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("XX"),
+					},
+					protocol.NewSimpleError("ERR NX and XX, GT or LT options at the same time are not compatible"),
+				),
+			},
+		},
+		"expireat command with gt and lt is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800"),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("LT"),
+					},
+					protocol.NewSimpleError("ERR GT and LT options at the same time are not compatible"),
+				),
+			},
+		},
+		"expireat command with overflowing expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("EXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("9223372036854775807"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'expireat' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestFlushAllValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"flushall command with no arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHALL"),
+					},
+				),
+			},
+		},
+		"flushall command with async is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHALL"),
+						protocol.NewBulkString("async"),
+					},
+				),
+			},
+		},
+		"flushall command with sync is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHALL"),
+						protocol.NewBulkString("SYNC"),
+					},
+				),
+			},
+		},
+		"flushall command with an unknown mode is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHALL"),
+						protocol.NewBulkString("LATER"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"flushall command with too many arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("FLUSHALL"),
+						protocol.NewBulkString("ASYNC"),
+						protocol.NewBulkString("SYNC"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestGetExValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"getex command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'getex' command"),
+				),
+			},
+		},
+		"getex command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"getex command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"getex command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'getex' command"),
+				),
+			},
+		},
+		"getex command with ex is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("10"),
+					},
+				),
+			},
+		},
+		"getex command with lower case pxat is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("pxat"),
+						protocol.NewBulkString("4102444800000"),
+					},
+				),
+			},
+		},
+		"getex command with persist is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("PERSIST"),
+					},
+				),
+			},
+		},
+		"getex command with two expiries is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("PX"),
+						protocol.NewBulkString("10"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"getex command with expiry and persist is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("10"),
+						protocol.NewBulkString("PERSIST"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"getex command with missing expiry is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("EX"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"getex command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("KEEPTTL"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"getex command with non-integer expiry is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("EX"),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"getex command with zero expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("PXAT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'getex' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
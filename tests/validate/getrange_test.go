@@ -0,0 +1,122 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestGetRangeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"getrange command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'getrange' command"),
+				),
+			},
+		},
+		"getrange command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"getrange command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+					},
+				),
+			},
+		},
+		"getrange command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'getrange' command"),
+				),
+			},
+		},
+		"getrange command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'getrange' command"),
+				),
+			},
+		},
+		"getrange command with integer start has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"getrange command with non-integer start is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("start"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"getrange command with non-integer end is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("GETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("end"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHelloValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"hello command with no arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+					},
+				),
+			},
+		},
+		"hello command with protocol version 2 is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"hello command with protocol version 3 is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+					},
+				),
+			},
+		},
+		"hello command with simple string protocol version has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewSimpleString("3"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"hello command with non-integer protocol version is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("three"),
+					},
+					protocol.NewSimpleError("ERR Protocol version is not an integer or out of range"),
+				),
+			},
+		},
+		"hello command with unsupported protocol version is not a protocol": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("NOPROTO unsupported protocol version"),
+				),
+			},
+		},
+		"hello command with client name is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("SETNAME"),
+						protocol.NewBulkString("my-client"),
+					},
+				),
+			},
+		},
+		"hello command with client name containing a space is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("SETNAME"),
+						protocol.NewBulkString("my client"),
+					},
+					protocol.NewSimpleError("ERR Client names cannot contain spaces, newlines or special characters."),
+				),
+			},
+		},
+		"hello command with client name option without a name is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("SETNAME"),
+					},
+					protocol.NewSimpleError("ERR Syntax error in HELLO option 'SETNAME'"),
+				),
+			},
+		},
+		"hello command with default user authentication is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("AUTH"),
+						protocol.NewBulkString("default"),
+						protocol.NewBulkString("password"),
+					},
+				),
+			},
+		},
+		"hello command with unknown user authentication is rejected": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("AUTH"),
+						protocol.NewBulkString("unknown"),
+						protocol.NewBulkString("password"),
+					},
+					protocol.NewSimpleError("WRONGPASS invalid username-password pair or user is disabled."),
+				),
+			},
+		},
+		"hello command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HELLO"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("UNKNOWN"),
+					},
+					protocol.NewSimpleError("ERR Syntax error in HELLO option 'UNKNOWN'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
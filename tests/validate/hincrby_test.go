@@ -0,0 +1,108 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHIncrByValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"hincrby command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrby' command"),
+				),
+			},
+		},
+		"hincrby command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"hincrby command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("5"),
+					},
+				),
+			},
+		},
+		"hincrby command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrby' command"),
+				),
+			},
+		},
+		"hincrby command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrby' command"),
+				),
+			},
+		},
+		"hincrby command with non-integer increment is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("1.5"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"hincrby command with negative increment is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("-5"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestHIncrByFloatValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"hincrbyfloat command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrbyfloat' command"),
+				),
+			},
+		},
+		"hincrbyfloat command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("1.5"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"hincrbyfloat command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("1.5"),
+					},
+				),
+			},
+		},
+		"hincrbyfloat command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrbyfloat' command"),
+				),
+			},
+		},
+		"hincrbyfloat command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'hincrbyfloat' command"),
+				),
+			},
+		},
+		"hincrbyfloat command with non-float increment is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+		"hincrbyfloat command with exponent increment is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("HINCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("field"),
+						protocol.NewBulkString("2.0e2"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestIncrByFloatValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"incrbyfloat command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'incrbyfloat' command"),
+				),
+			},
+		},
+		"incrbyfloat command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("1.5"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"incrbyfloat command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1.5"),
+					},
+				),
+			},
+		},
+		"incrbyfloat command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'incrbyfloat' command"),
+				),
+			},
+		},
+		"incrbyfloat command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'incrbyfloat' command"),
+				),
+			},
+		},
+		"incrbyfloat command with integer increment has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"incrbyfloat command with non-float increment is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+		"incrbyfloat command with padded increment is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("INCRBYFLOAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString(" 1.5"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLcsValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lcs command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lcs' command"),
+				),
+			},
+		},
+		"lcs command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("key2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lcs command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+					},
+				),
+			},
+		},
+		"lcs command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lcs' command"),
+				),
+			},
+		},
+		"lcs command with every option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("idx"),
+						protocol.NewBulkString("minmatchlen"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("withmatchlen"),
+					},
+				),
+			},
+		},
+		"lcs command with len is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("LEN"),
+					},
+				),
+			},
+		},
+		"lcs command with len and idx asks for idx alone": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("LEN"),
+						protocol.NewBulkString("IDX"),
+					},
+					protocol.NewSimpleError("ERR If you want both the length and indexes, please just use IDX."),
+				),
+			},
+		},
+		"lcs command with minmatchlen missing its value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("IDX"),
+						protocol.NewBulkString("MINMATCHLEN"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lcs command with non-integer minmatchlen is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("IDX"),
+						protocol.NewBulkString("MINMATCHLEN"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"lcs command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LCS"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("LONGEST"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLIndexValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lindex command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lindex' command"),
+				),
+			},
+		},
+		"lindex command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lindex command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+				),
+			},
+		},
+		"lindex command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lindex' command"),
+				),
+			},
+		},
+		"lindex command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lindex' command"),
+				),
+			},
+		},
+		"lindex command with integer index has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"lindex command with non-integer index is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINDEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("first"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLInsertValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"linsert command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'linsert' command"),
+				),
+			},
+		},
+		"linsert command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"linsert command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"linsert command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("pivot"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'linsert' command"),
+				),
+			},
+		},
+		"linsert command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'linsert' command"),
+				),
+			},
+		},
+		"linsert command with lowercase after is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("after"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"linsert command with simple string value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("BEFORE"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewSimpleString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"linsert command with unknown position is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LINSERT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("BETWEEN"),
+						protocol.NewBulkString("pivot"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLMoveValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lmove command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lmove' command"),
+				),
+			},
+		},
+		"lmove command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lmove command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+				),
+			},
+		},
+		"lmove command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lmove' command"),
+				),
+			},
+		},
+		"lmove command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lmove' command"),
+				),
+			},
+		},
+		"lmove command with lowercase directions is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("right"),
+						protocol.NewBulkString("left"),
+					},
+				),
+			},
+		},
+		"lmove command with simple string destination has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewSimpleString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lmove command with unknown source direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("UP"),
+						protocol.NewBulkString("RIGHT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lmove command with unknown destination direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMOVE"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("DOWN"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
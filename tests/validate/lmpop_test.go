@@ -0,0 +1,182 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLMPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lmpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lmpop' command"),
+				),
+			},
+		},
+		"lmpop command without direction has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lmpop' command"),
+				),
+			},
+		},
+		"lmpop command with integer number of keys has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"lmpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lmpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("right"),
+						protocol.NewBulkString("count"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"lmpop command with zero number of keys is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"lmpop command with non-integer number of keys is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"lmpop command with more keys than given is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lmpop command with unknown direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("UP"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lmpop command with zero count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR count should be greater than 0"),
+				),
+			},
+		},
+		"lmpop command with count missing its value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("COUNT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lmpop command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
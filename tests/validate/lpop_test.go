@@ -0,0 +1,123 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lpop' command"),
+				),
+			},
+		},
+		"lpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"lpop command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lpop' command"),
+				),
+			},
+		},
+		"lpop command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lpop' command"),
+				),
+			},
+		},
+		"lpop command with integer count has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"lpop command with bulk string count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"lpop command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"lpop command with negative count must be positive": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR value is out of range, must be positive"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLPosValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lpos command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lpos' command"),
+				),
+			},
+		},
+		"lpos command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lpos command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"lpos command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lpos' command"),
+				),
+			},
+		},
+		"lpos command with simple string value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lpos command with every option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("rank"),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("count"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("maxlen"),
+						protocol.NewBulkString("10"),
+					},
+				),
+			},
+		},
+		"lpos command with zero rank is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("RANK"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR RANK can't be zero: use 1 to start from the first match, 2 from the second ... or use negative to start from the end of the list"),
+				),
+			},
+		},
+		"lpos command with negative count is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR COUNT can't be negative"),
+				),
+			},
+		},
+		"lpos command with negative maximum length is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("MAXLEN"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR MAXLEN can't be negative"),
+				),
+			},
+		},
+		"lpos command with non-integer rank is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("RANK"),
+						protocol.NewBulkString("first"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"lpos command with option without value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("COUNT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lpos command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LPOS"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
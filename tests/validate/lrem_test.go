@@ -0,0 +1,109 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLRemValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lrem command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lrem' command"),
+				),
+			},
+		},
+		"lrem command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lrem command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"lrem command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lrem' command"),
+				),
+			},
+		},
+		"lrem command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lrem' command"),
+				),
+			},
+		},
+		"lrem command with integer count has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"lrem command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LREM"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("all"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestLScanValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"lscan command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lscan' command"),
+				),
+			},
+		},
+		"lscan command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"lscan command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+				),
+			},
+		},
+		"lscan command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'lscan' command"),
+				),
+			},
+		},
+		"lscan command with every option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("key*"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("5"),
+					},
+				),
+			},
+		},
+		"lscan command with a negative cursor is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR invalid cursor"),
+				),
+			},
+		},
+		"lscan command with a non-integer cursor is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleError("ERR invalid cursor"),
+				),
+			},
+		},
+		"lscan command with a missing option value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lscan command with a non-integer count is not an integer": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"lscan command with a zero count is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lscan command with an unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"lscan command with a type is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("LSCAN"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("TYPE"),
+						protocol.NewBulkString("list"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
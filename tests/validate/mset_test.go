@@ -0,0 +1,95 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestMSetValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"mset command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'mset' command"),
+				),
+			},
+		},
+		"mset command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("value2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"mset command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("value2"),
+					},
+				),
+			},
+		},
+		"mset command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewBulkString("key1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'mset' command"),
+				),
+			},
+		},
+		"mset command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'mset' command"),
+				),
+			},
+		},
+		"mset command with integer value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSET"),
+						protocol.NewBulkString("key1"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
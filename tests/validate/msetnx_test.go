@@ -0,0 +1,95 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestMSetNxValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"msetnx command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'msetnx' command"),
+				),
+			},
+		},
+		"msetnx command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("value2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"msetnx command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("value2"),
+					},
+				),
+			},
+		},
+		"msetnx command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'msetnx' command"),
+				),
+			},
+		},
+		"msetnx command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("value1"),
+						protocol.NewBulkString("key2"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'msetnx' command"),
+				),
+			},
+		},
+		"msetnx command with integer value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("MSETNX"),
+						protocol.NewBulkString("key1"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
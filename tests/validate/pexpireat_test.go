@@ -0,0 +1,117 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestPExpireAtValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"pexpireat command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pexpireat' command"),
+				),
+			},
+		},
+		"pexpireat command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("4102444800000"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"pexpireat command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800000"),
+					},
+				),
+			},
+		},
+		"pexpireat command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pexpireat' command"),
+				),
+			},
+		},
+		"pexpireat command with every condition option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800000"),
+						protocol.NewBulkString("xx"),
+						protocol.NewBulkString("lt"),
+					},
+				),
+			},
+		},
+		"pexpireat command with integer expiry has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(10),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"pexpireat command with non-integer expiry is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"pexpireat command with nx and gt is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PEXPIREAT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4102444800000"),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("GT"),
+					},
+					protocol.NewSimpleError("ERR NX and XX, GT or LT options at the same time are not compatible"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestPSetExValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"psetex command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'psetex' command"),
+				),
+			},
+		},
+		"psetex command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"psetex command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"psetex command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'psetex' command"),
+				),
+			},
+		},
+		"psetex command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'psetex' command"),
+				),
+			},
+		},
+		"psetex command with integer expiry has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(100),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"psetex command with non-integer expiry is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("ten"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"psetex command with zero expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'psetex' command"),
+				),
+			},
+		},
+		"psetex command with negative expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'psetex' command"),
+				),
+			},
+		},
+		"psetex command with overflowing expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PSETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("9223372036854775807"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'psetex' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
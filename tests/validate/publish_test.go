@@ -0,0 +1,79 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestPublishValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"publish command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBLISH"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'publish' command"),
+				),
+			},
+		},
+		"publish command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBLISH"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("message"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"publish command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBLISH"),
+						protocol.NewBulkString("channel"),
+						protocol.NewBulkString("message"),
+					},
+				),
+			},
+		},
+		"publish command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBLISH"),
+						protocol.NewBulkString("channel"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'publish' command"),
+				),
+			},
+		},
+		"publish command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBLISH"),
+						protocol.NewBulkString("channel"),
+						protocol.NewBulkString("message"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'publish' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestPubSubValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"pubsub command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pubsub' command"),
+				),
+			},
+		},
+		"pubsub command with simple string subcommand has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewSimpleString("CHANNELS"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"pubsub command with an unknown subcommand is an error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("LIST"),
+					},
+					protocol.NewSimpleError("ERR unknown subcommand 'LIST'. Try PUBSUB HELP."),
+				),
+			},
+		},
+		"pubsub channels command is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("CHANNELS"),
+					},
+				),
+			},
+		},
+		"pubsub channels command with a pattern is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("channels"),
+						protocol.NewBulkString("news.*"),
+					},
+				),
+			},
+		},
+		"pubsub channels command with two patterns has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("CHANNELS"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pubsub|channels' command"),
+				),
+			},
+		},
+		"pubsub numsub command is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("NUMSUB"),
+					},
+				),
+			},
+		},
+		"pubsub numsub command with channels is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("NUMSUB"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+				),
+			},
+		},
+		"pubsub numpat command is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("NUMPAT"),
+					},
+				),
+			},
+		},
+		"pubsub numpat command with an argument has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("NUMPAT"),
+						protocol.NewBulkString("a"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pubsub|numpat' command"),
+				),
+			},
+		},
+		"pubsub shardchannels command with a pattern is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("SHARDCHANNELS"),
+						protocol.NewBulkString("news.*"),
+					},
+				),
+			},
+		},
+		"pubsub shardchannels command with two patterns has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("SHARDCHANNELS"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'pubsub|shardchannels' command"),
+				),
+			},
+		},
+		"pubsub shardnumsub command with channels is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("PUBSUB"),
+						protocol.NewBulkString("SHARDNUMSUB"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("b"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestRenameValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"rename command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rename' command"),
+				),
+			},
+		},
+		"rename command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("destination"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"rename command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+					},
+				),
+			},
+		},
+		"rename command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+						protocol.NewBulkString("source"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rename' command"),
+				),
+			},
+		},
+		"rename command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RENAME"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rename' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
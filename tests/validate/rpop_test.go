@@ -0,0 +1,123 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestRPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"rpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpop' command"),
+				),
+			},
+		},
+		"rpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"rpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"rpop command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpop' command"),
+				),
+			},
+		},
+		"rpop command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpop' command"),
+				),
+			},
+		},
+		"rpop command with integer count has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"rpop command with bulk string count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"rpop command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"rpop command with negative count must be positive": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR value is out of range, must be positive"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
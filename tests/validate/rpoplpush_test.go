@@ -0,0 +1,91 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestRPopLPushValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"rpoplpush command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpoplpush' command"),
+				),
+			},
+		},
+		"rpoplpush command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("destination"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"rpoplpush command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+					},
+				),
+			},
+		},
+		"rpoplpush command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewBulkString("source"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpoplpush' command"),
+				),
+			},
+		},
+		"rpoplpush command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'rpoplpush' command"),
+				),
+			},
+		},
+		"rpoplpush command with integer destination has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("RPOPLPUSH"),
+						protocol.NewBulkString("source"),
+						protocol.NewSimpleInteger(1),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
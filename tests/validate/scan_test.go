@@ -0,0 +1,175 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestScanValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"scan command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'scan' command"),
+				),
+			},
+		},
+		"scan command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"scan command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+					},
+				),
+			},
+		},
+		"scan command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'scan' command"),
+				),
+			},
+		},
+		"scan command with every option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+						protocol.NewBulkString("key*"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("5"),
+					},
+				),
+			},
+		},
+		"scan command with a negative cursor is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR invalid cursor"),
+				),
+			},
+		},
+		"scan command with a non-integer cursor is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("zero"),
+					},
+					protocol.NewSimpleError("ERR invalid cursor"),
+				),
+			},
+		},
+		"scan command with a missing option value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("MATCH"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"scan command with a non-integer count is not an integer": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("ten"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"scan command with a zero count is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"scan command with an unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("5"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"scan command with a type is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("TYPE"),
+						protocol.NewBulkString("hash"),
+					},
+				),
+			},
+		},
+		"scan command with an unknown type is not allowed": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SCAN"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("TYPE"),
+						protocol.NewBulkString("box"),
+					},
+					protocol.NewSimpleError("ERR unknown type name 'box'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
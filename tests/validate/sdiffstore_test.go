@@ -0,0 +1,78 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSDiffStoreValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"sdiffstore command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'sdiffstore' command"),
+				),
+			},
+		},
+		"sdiffstore command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"sdiffstore command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"sdiffstore command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'sdiffstore' command"),
+				),
+			},
+		},
+		"sdiffstore command with multiple keys is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("k2"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
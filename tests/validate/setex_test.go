@@ -0,0 +1,148 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSetExValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"setex command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setex' command"),
+				),
+			},
+		},
+		"setex command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"setex command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"setex command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setex' command"),
+				),
+			},
+		},
+		"setex command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("100"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setex' command"),
+				),
+			},
+		},
+		"setex command with integer expiry has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(100),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"setex command with non-integer expiry is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("ten"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"setex command with zero expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'setex' command"),
+				),
+			},
+		},
+		"setex command with negative expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'setex' command"),
+				),
+			},
+		},
+		"setex command with overflowing expiry is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("9223372036854775807"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR invalid expire time in 'setex' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSetRangeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"setrange command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setrange' command"),
+				),
+			},
+		},
+		"setrange command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"setrange command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+					},
+				),
+			},
+		},
+		"setrange command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setrange' command"),
+				),
+			},
+		},
+		"setrange command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("value"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'setrange' command"),
+				),
+			},
+		},
+		"setrange command with integer offset has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"setrange command with non-integer offset is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("first"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"setrange command with negative offset is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SETRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+						protocol.NewBulkString("value"),
+					},
+					protocol.NewSimpleError("ERR offset is out of range"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSInterCardValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"sintercard command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'sintercard' command"),
+				),
+			},
+		},
+		"sintercard command with only numkeys has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'sintercard' command"),
+				),
+			},
+		},
+		"sintercard command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"sintercard command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("k2"),
+					},
+				),
+			},
+		},
+		"sintercard command with limit is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("5"),
+					},
+				),
+			},
+		},
+		"sintercard command with lowercase limit is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("limit"),
+						protocol.NewBulkString("0"),
+					},
+				),
+			},
+		},
+		"sintercard command with zero numkeys is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("k1"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"sintercard command with non-integer numkeys is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("k1"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"sintercard command with more numkeys than keys is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("k2"),
+					},
+					protocol.NewSimpleError("ERR Number of keys can't be greater than number of args"),
+				),
+			},
+		},
+		"sintercard command with negative limit is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR LIMIT can't be negative"),
+				),
+			},
+		},
+		"sintercard command with non-integer limit is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("few"),
+					},
+					protocol.NewSimpleError("ERR LIMIT can't be negative"),
+				),
+			},
+		},
+		"sintercard command with limit without a value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("LIMIT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"sintercard command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SINTERCARD"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("k1"),
+						protocol.NewBulkString("k2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
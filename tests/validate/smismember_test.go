@@ -0,0 +1,78 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSMIsMemberValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"smismember command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMISMEMBER"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'smismember' command"),
+				),
+			},
+		},
+		"smismember command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMISMEMBER"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"smismember command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SMISMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("member"),
+					},
+				),
+			},
+		},
+		"smismember command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SMISMEMBER"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'smismember' command"),
+				),
+			},
+		},
+		"smismember command with multiple members is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SMISMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("m2"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"spop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'spop' command"),
+				),
+			},
+		},
+		"spop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"spop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"spop command with count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("3"),
+					},
+				),
+			},
+		},
+		"spop command with zero count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+				),
+			},
+		},
+		"spop command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("three"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"spop command with negative count must be positive": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-1"),
+					},
+					protocol.NewSimpleError("ERR value is out of range, must be positive"),
+				),
+			},
+		},
+		"spop command with too many arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPOP"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
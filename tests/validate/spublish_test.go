@@ -0,0 +1,79 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSPublishValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"spublish command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPUBLISH"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'spublish' command"),
+				),
+			},
+		},
+		"spublish command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPUBLISH"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("message"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"spublish command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SPUBLISH"),
+						protocol.NewBulkString("channel"),
+						protocol.NewBulkString("message"),
+					},
+				),
+			},
+		},
+		"spublish command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPUBLISH"),
+						protocol.NewBulkString("channel"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'spublish' command"),
+				),
+			},
+		},
+		"spublish command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SPUBLISH"),
+						protocol.NewBulkString("channel"),
+						protocol.NewBulkString("message"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'spublish' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
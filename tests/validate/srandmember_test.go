@@ -0,0 +1,123 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSRandMemberValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"srandmember command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'srandmember' command"),
+				),
+			},
+		},
+		"srandmember command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"srandmember command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"srandmember command with count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("3"),
+					},
+				),
+			},
+		},
+		"srandmember command with negative count is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-3"),
+					},
+				),
+			},
+		},
+		"srandmember command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("three"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"srandmember command with too many arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"srandmember command with count below -LONG_MAX is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-9223372036854775808"),
+					},
+					protocol.NewSimpleError("ERR value is out of range"),
+				),
+			},
+		},
+		"srandmember command with count of -LONG_MAX is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-9223372036854775807"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSSubscribeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"ssubscribe command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SSUBSCRIBE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'ssubscribe' command"),
+				),
+			},
+		},
+		"ssubscribe command with simple string argument has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SSUBSCRIBE"),
+						protocol.NewSimpleString("channel"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"ssubscribe command with one argument is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SSUBSCRIBE"),
+						protocol.NewBulkString("channel"),
+					},
+				),
+			},
+		},
+		"ssubscribe command with several arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SSUBSCRIBE"),
+						protocol.NewBulkString("{a}1"),
+						protocol.NewBulkString("{a}2"),
+						protocol.NewBulkString("{a}3"),
+					},
+				),
+			},
+		},
+		"ssubscribe command with channels in different slots is a cross slot error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SSUBSCRIBE"),
+						protocol.NewBulkString("foo"),
+						protocol.NewBulkString("bar"),
+					},
+					protocol.NewSimpleError("CROSSSLOT Keys in request don't hash to the same slot"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
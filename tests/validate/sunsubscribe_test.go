@@ -0,0 +1,66 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSUnsubscribeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"sunsubscribe command with no arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SUNSUBSCRIBE"),
+					},
+				),
+			},
+		},
+		"sunsubscribe command with simple string argument has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SUNSUBSCRIBE"),
+						protocol.NewSimpleString("channel"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"sunsubscribe command with several arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SUNSUBSCRIBE"),
+						protocol.NewBulkString("{a}1"),
+						protocol.NewBulkString("{a}2"),
+						protocol.NewBulkString("{a}3"),
+					},
+				),
+			},
+		},
+		"sunsubscribe command with channels in different slots is a cross slot error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SUNSUBSCRIBE"),
+						protocol.NewBulkString("foo"),
+						protocol.NewBulkString("bar"),
+					},
+					protocol.NewSimpleError("CROSSSLOT Keys in request don't hash to the same slot"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestSwapDBValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"swapdb command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'swapdb' command"),
+				),
+			},
+		},
+		"swapdb command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"swapdb command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+					},
+				),
+			},
+		},
+		"swapdb command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'swapdb' command"),
+				),
+			},
+		},
+		"swapdb command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'swapdb' command"),
+				),
+			},
+		},
+		"swapdb command with non-integer first index is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("zero"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR invalid first DB index"),
+				),
+			},
+		},
+		"swapdb command with non-integer second index is invalid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("SWAPDB"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("one"),
+					},
+					protocol.NewSimpleError("ERR invalid second DB index"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZAddValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zadd command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zadd' command"),
+				),
+			},
+		},
+		"zadd command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zadd command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+				),
+			},
+		},
+		"zadd command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zadd' command"),
+				),
+			},
+		},
+		"zadd command with multiple score member pairs is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2.5"),
+						protocol.NewBulkString("m2"),
+					},
+				),
+			},
+		},
+		"zadd command with every compatible option is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("xx"),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("CH"),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+				),
+			},
+		},
+		"zadd command with infinite score is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("member"),
+					},
+				),
+			},
+		},
+		"zadd command with a score without a member is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zadd command with only options is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("CH"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zadd command with NX and XX is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("XX"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR XX and NX options at the same time are not compatible"),
+				),
+			},
+		},
+		"zadd command with GT and NX is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("NX"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR GT, LT, and/or NX options at the same time are not compatible"),
+				),
+			},
+		},
+		"zadd command with GT and LT is not compatible": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("GT"),
+						protocol.NewBulkString("LT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR GT, LT, and/or NX options at the same time are not compatible"),
+				),
+			},
+		},
+		"zadd command with INCR and multiple pairs is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("INCR"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("m1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("m2"),
+					},
+					protocol.NewSimpleError("ERR INCR option supports a single increment-element pair"),
+				),
+			},
+		},
+		"zadd command with a score that is not a float is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+		"zadd command with a NaN score is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("nan"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+		"zadd command with integer member has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZADD"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1"),
+						protocol.NewSimpleInteger(42),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZCountValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zcount command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zcount' command"),
+				),
+			},
+		},
+		"zcount command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("(2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zcount command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("(2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zcount command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zcount command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("(2"),
+					},
+				),
+			},
+		},
+		"zcount command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("(2"),
+					},
+					protocol.NewSimpleError("ERR min or max is not a float"),
+				),
+			},
+		},
+		"zcount command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("((2"),
+					},
+					protocol.NewSimpleError("ERR min or max is not a float"),
+				),
+			},
+		},
+		"zcount command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-inf"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zcount' command"),
+				),
+			},
+		},
+		"zcount command with extra value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zcount' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZDiffStoreValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zdiffstore command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zdiffstore' command"),
+				),
+			},
+		},
+		"zdiffstore command with simple string destination has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewSimpleString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zdiffstore command with integer number of keys has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zdiffstore command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+					},
+				),
+			},
+		},
+		"zdiffstore command without keys has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zdiffstore' command"),
+				),
+			},
+		},
+		"zdiffstore command with non-integer number of keys is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zdiffstore command with zero keys needs a key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR at least 1 input key is needed for 'zdiffstore' command"),
+				),
+			},
+		},
+		"zdiffstore command with more keys than arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zdiffstore command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zdiffstore command with weights is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zdiffstore command with aggregate is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZDIFFSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("AGGREGATE"),
+						protocol.NewBulkString("SUM"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
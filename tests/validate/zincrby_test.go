@@ -0,0 +1,96 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZIncrByValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zincrby command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zincrby' command"),
+				),
+			},
+		},
+		"zincrby command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zincrby command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("member"),
+					},
+				),
+			},
+		},
+		"zincrby command with too few arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1.5"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zincrby' command"),
+				),
+			},
+		},
+		"zincrby command with too many arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("1.5"),
+						protocol.NewBulkString("member"),
+						protocol.NewBulkString("extra"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zincrby' command"),
+				),
+			},
+		},
+		"zincrby command with an increment that is not a float is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINCRBY"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("member"),
+					},
+					protocol.NewSimpleError("ERR value is not a valid float"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
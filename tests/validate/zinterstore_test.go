@@ -0,0 +1,231 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZInterStoreValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zinterstore command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zinterstore' command"),
+				),
+			},
+		},
+		"zinterstore command with simple string destination has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewSimpleString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zinterstore command with integer number of keys has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zinterstore command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+					},
+				),
+			},
+		},
+		"zinterstore command without keys has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zinterstore' command"),
+				),
+			},
+		},
+		"zinterstore command with non-integer number of keys is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zinterstore command with zero keys needs a key": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR at least 1 input key is needed for 'zinterstore' command"),
+				),
+			},
+		},
+		"zinterstore command with more keys than arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("3"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zinterstore command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zinterstore command with weights and aggregate is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("weights"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("-inf"),
+						protocol.NewBulkString("aggregate"),
+						protocol.NewBulkString("max"),
+					},
+				),
+			},
+		},
+		"zinterstore command with too few weights is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zinterstore command with too many weights is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zinterstore command with non-float weight is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("WEIGHTS"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR weight value is not a float"),
+				),
+			},
+		},
+		"zinterstore command with unknown aggregate is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("AGGREGATE"),
+						protocol.NewBulkString("AVG"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zinterstore command with aggregate without value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZINTERSTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("AGGREGATE"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZLexCountValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zlexcount command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zlexcount' command"),
+				),
+			},
+		},
+		"zlexcount command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("[b"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zlexcount command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("[b"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zlexcount command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zlexcount command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("[b"),
+					},
+				),
+			},
+		},
+		"zlexcount command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("[b"),
+					},
+					protocol.NewSimpleError("ERR min or max not valid string range item"),
+				),
+			},
+		},
+		"zlexcount command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("b"),
+					},
+					protocol.NewSimpleError("ERR min or max not valid string range item"),
+				),
+			},
+		},
+		"zlexcount command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zlexcount' command"),
+				),
+			},
+		},
+		"zlexcount command with extra value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZLEXCOUNT"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zlexcount' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
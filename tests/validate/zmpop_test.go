@@ -0,0 +1,183 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZMPopValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zmpop command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zmpop' command"),
+				),
+			},
+		},
+		"zmpop command with integer number of keys has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewSimpleInteger(1),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zmpop command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zmpop command with bulk string arguments is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key1"),
+						protocol.NewBulkString("key2"),
+						protocol.NewBulkString("max"),
+						protocol.NewBulkString("count"),
+						protocol.NewBulkString("10"),
+					},
+				),
+			},
+		},
+		"zmpop command without direction has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zmpop' command"),
+				),
+			},
+		},
+		"zmpop command with zero keys is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"zmpop command with non-integer number of keys is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("one"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("ERR numkeys should be greater than 0"),
+				),
+			},
+		},
+		"zmpop command with more keys than arguments is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zmpop command with unknown direction is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("LEFT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zmpop command with zero count is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR count should be greater than 0"),
+				),
+			},
+		},
+		"zmpop command with count without value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+						protocol.NewBulkString("COUNT"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zmpop command with repeated count is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZMPOP"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("MIN"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("COUNT"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRandMemberValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zrandmember command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zrandmember' command"),
+				),
+			},
+		},
+		"zrandmember command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewSimpleString("key"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zrandmember command with integer count has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zrandmember command with bulk string key is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+					},
+				),
+			},
+		},
+		"zrandmember command with negative count and scores is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-2"),
+						protocol.NewBulkString("withscores"),
+					},
+				),
+			},
+		},
+		"zrandmember command with non-integer count is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrandmember command with unknown option is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("WITHSCORE"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zrandmember command with extra argument is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("WITHSCORES"),
+						protocol.NewBulkString("3"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zrandmember command with count below -LONG_MAX is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-9223372036854775808"),
+					},
+					protocol.NewSimpleError("ERR value is out of range"),
+				),
+			},
+		},
+		"zrandmember command with count of -LONG_MAX is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-9223372036854775807"),
+					},
+				),
+			},
+		},
+		"zrandmember command with scores and count beyond half of LONG_MAX is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-4611686018427387904"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewSimpleError("ERR value is out of range"),
+				),
+			},
+		},
+		"zrandmember command with scores and count of half of LONG_MAX is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANDMEMBER"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("4611686018427387903"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRangeValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zrange command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zrange' command"),
+				),
+			},
+		},
+		"zrange command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zrange command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zrange command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zrange command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"zrange command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("zero"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrange command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrange command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zrange' command"),
+				),
+			},
+		},
+		"zrange command with bulk string and 2 integers and extra value is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zrange command with every option by score is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(1"),
+						protocol.NewBulkString("+inf"),
+						protocol.NewBulkString("byscore"),
+						protocol.NewBulkString("rev"),
+						protocol.NewBulkString("limit"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("withscores"),
+					},
+				),
+			},
+		},
+		"zrange command by lex is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("[a"),
+						protocol.NewBulkString("(c"),
+						protocol.NewBulkString("BYLEX"),
+					},
+				),
+			},
+		},
+		"zrange command with invalid score is not a float": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(one"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("BYSCORE"),
+					},
+					protocol.NewSimpleError("ERR min or max is not a float"),
+				),
+			},
+		},
+		"zrange command with invalid lex range item is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("a"),
+						protocol.NewBulkString("+"),
+						protocol.NewBulkString("BYLEX"),
+					},
+					protocol.NewSimpleError("ERR min or max not valid string range item"),
+				),
+			},
+		},
+		"zrange command with limit by rank is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX"),
+				),
+			},
+		},
+		"zrange command with scores by lex is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+						protocol.NewBulkString("BYLEX"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewSimpleError("ERR syntax error, WITHSCORES not supported in combination with BYLEX"),
+				),
+			},
+		},
+		"zrange command with limit without count is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+		"zrange command with non-integer limit is out of range": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("zero"),
+						protocol.NewBulkString("1"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrange command by score and lex is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("BYLEX"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRangeStoreValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zrangestore command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zrangestore' command"),
+				),
+			},
+		},
+		"zrangestore command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewSimpleString("source"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zrangestore command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zrangestore command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("0"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zrangestore command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"zrangestore command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("zero"),
+						protocol.NewBulkString("2"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrangestore command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR value is not an integer or out of range"),
+				),
+			},
+		},
+		"zrangestore command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("0"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zrangestore' command"),
+				),
+			},
+		},
+		"zrangestore command by score with limit is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("1"),
+						protocol.NewBulkString("5"),
+						protocol.NewBulkString("BYSCORE"),
+						protocol.NewBulkString("LIMIT"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+					},
+				),
+			},
+		},
+		"zrangestore command with scores is a syntax error": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZRANGESTORE"),
+						protocol.NewBulkString("destination"),
+						protocol.NewBulkString("source"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("WITHSCORES"),
+					},
+					protocol.NewSimpleError("ERR syntax error"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
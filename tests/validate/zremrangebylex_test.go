@@ -0,0 +1,135 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRemRangeByLexValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zremrangebylex command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebylex' command"),
+				),
+			},
+		},
+		"zremrangebylex command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("(a"),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zremrangebylex command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zremrangebylex command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(a"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zremrangebylex command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(a"),
+						protocol.NewBulkString("+"),
+					},
+				),
+			},
+		},
+		"zremrangebylex command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString(""),
+						protocol.NewBulkString("+"),
+					},
+					protocol.NewSimpleError("ERR min or max not valid string range item"),
+				),
+			},
+		},
+		"zremrangebylex command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(a"),
+						protocol.NewBulkString("z"),
+					},
+					protocol.NewSimpleError("ERR min or max not valid string range item"),
+				),
+			},
+		},
+		"zremrangebylex command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(a"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebylex' command"),
+				),
+			},
+		},
+		"zremrangebylex command with extra value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYLEX"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("-"),
+						protocol.NewBulkString("+"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebylex' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package validate_test
+
+import (
+	"redis-challenge/internal/protocol"
+	"redis-challenge/tests"
+	"redis-challenge/tests/call"
+	"testing"
+)
+
+func TestZRemRangeByScoreValidation(t *testing.T) {
+	testCases := map[string]struct {
+		calls        []call.DataCall
+		driverChoice tests.SelectTestCaseDriver
+	}{
+		"zremrangebyscore command with no arguments has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebyscore' command"),
+				),
+			},
+		},
+		"zremrangebyscore command with simple string key has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewSimpleString("key"),
+						protocol.NewBulkString("(1"),
+						protocol.NewBulkString("+inf"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got '+'"),
+				),
+			},
+		},
+		"zremrangebyscore command with integer first range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewSimpleInteger(0),
+						protocol.NewBulkString("+inf"),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zremrangebyscore command with integer second range value has bad type": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(1"),
+						protocol.NewSimpleInteger(2),
+					},
+					protocol.NewSimpleError("ERR Protocol error: expected '$', got ':'"),
+				),
+			},
+		},
+		"zremrangebyscore command with bulk string and bulk strings for the range values is ok": {
+			calls: []call.DataCall{
+				call.NewFromDataWithoutError(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(1"),
+						protocol.NewBulkString("+inf"),
+					},
+				),
+			},
+		},
+		"zremrangebyscore command with invalid left range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("nan"),
+						protocol.NewBulkString("+inf"),
+					},
+					protocol.NewSimpleError("ERR min or max is not a float"),
+				),
+			},
+		},
+		"zremrangebyscore command with invalid right range is not valid": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(1"),
+						protocol.NewBulkString("two"),
+					},
+					protocol.NewSimpleError("ERR min or max is not a float"),
+				),
+			},
+		},
+		"zremrangebyscore command without stop value is too short": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("(1"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebyscore' command"),
+				),
+			},
+		},
+		"zremrangebyscore command with extra value has the wrong length": {
+			calls: []call.DataCall{
+				call.NewFromData(
+					[]protocol.Data{
+						protocol.NewBulkString("ZREMRANGEBYSCORE"),
+						protocol.NewBulkString("key"),
+						protocol.NewBulkString("0"),
+						protocol.NewBulkString("2"),
+						protocol.NewBulkString("4"),
+					},
+					protocol.NewSimpleError("ERR wrong number of arguments for 'zremrangebyscore' command"),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tests.ValidateCommands(t, testCase.calls, testCase.driverChoice)
+		})
+	}
+}